@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	dummyarticlecache "github.com/egor3f/rssalchemy/internal/articlecache/dummy"
 	"github.com/egor3f/rssalchemy/internal/config"
 	dummycookies "github.com/egor3f/rssalchemy/internal/cookiemgr/dummy"
 	"github.com/egor3f/rssalchemy/internal/dateparser"
 	"github.com/egor3f/rssalchemy/internal/extractors/pwextractor"
+	dummyfirstseen "github.com/egor3f/rssalchemy/internal/firstseen/dummy"
 	"github.com/egor3f/rssalchemy/internal/limiter/dummy"
 	"github.com/egor3f/rssalchemy/internal/models"
 	"github.com/felixge/fgprof"
@@ -24,6 +26,7 @@ func main() {
 	outFile := flag.String("o", "", "Output file name")
 	skipOutput := flag.Bool("s", false, "Skip json output; show just logs")
 	useProfiler := flag.Bool("p", false, "Use profiler")
+	debugScreenshot := flag.Bool("d", false, "Save debug screenshot and selectors report even if extraction succeeded")
 	flag.Parse()
 
 	if *useProfiler {
@@ -70,8 +73,10 @@ func main() {
 				return time.Date(2025, 01, 10, 10, 00, 00, 00, time.UTC)
 			},
 		},
-		CookieManager: dummycookies.New(),
-		Limiter:       &dummy.Limiter{},
+		CookieManager:  dummycookies.New(),
+		FirstSeenStore: dummyfirstseen.New(),
+		ArticleCache:   dummyarticlecache.New(),
+		Limiter:        &dummy.Limiter{},
 	})
 	if err != nil {
 		log.Panicf("create pw extractor: %v", err)
@@ -83,21 +88,26 @@ func main() {
 	}()
 
 	start := time.Now()
-	result, err := pwe.Extract(task)
+	var result *models.TaskResult
+	switch {
+	case task.TaskType == models.TaskTypeJsonApi:
+		result, err = pwe.ExtractJson(task)
+	case task.RenderMode == models.RenderMode_Static:
+		result, err = pwe.ExtractStatic(task)
+	case task.RenderMode == models.RenderMode_Auto:
+		result, err = pwe.ExtractAuto(task)
+	default:
+		result, err = pwe.Extract(task)
+	}
 	log.Infof("Extract took %v ms", time.Since(start).Milliseconds())
 	if err != nil {
 		log.Errorf("extract: %v", err)
-		scrResult, err := pwe.Screenshot(task)
-		if err != nil {
-			log.Errorf("screenshot failed: %v", err)
-			panic(err)
-		}
-		err = os.WriteFile("screenshot.png", scrResult.Image, 0600)
-		if err != nil {
-			log.Errorf("screenshot save failed: %v", err)
-		}
+		saveDebugScreenshot(pwe, task)
 		panic(err)
 	}
+	if *debugScreenshot {
+		saveDebugScreenshot(pwe, task)
+	}
 
 	if !*skipOutput {
 		resultStr, err := json.MarshalIndent(result, "", "\t")
@@ -112,6 +122,32 @@ func main() {
 	}
 }
 
+// saveDebugScreenshot writes screenshot.png with outlined selector matches and debug_report.json with match counts
+func saveDebugScreenshot(pwe *pwextractor.PwExtractor, task models.Task) {
+	debugResult, err := pwe.DebugScreenshot(task)
+	if err != nil {
+		log.Errorf("debug screenshot failed: %v", err)
+		return
+	}
+	if err := os.WriteFile("screenshot.png", debugResult.Image, 0600); err != nil {
+		log.Errorf("screenshot save failed: %v", err)
+	}
+	for _, report := range debugResult.Selectors {
+		log.Infof(
+			"Selector %s %q: matches=%d, posts=%d %s",
+			report.Field, report.Selector, report.Matches, report.Posts, report.Error,
+		)
+	}
+	report, err := json.MarshalIndent(debugResult.Selectors, "", "\t")
+	if err != nil {
+		log.Errorf("marshal debug report: %v", err)
+		return
+	}
+	if err := os.WriteFile("debug_report.json", report, 0600); err != nil {
+		log.Errorf("debug report save failed: %v", err)
+	}
+}
+
 func loadTask(taskFileName string) (models.Task, error) {
 	taskFile, err := os.Open(taskFileName)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/egor3f/rssalchemy/internal/adapters/natsadapter"
 	httpApi "github.com/egor3f/rssalchemy/internal/api/http"
 	"github.com/egor3f/rssalchemy/internal/config"
+	"github.com/egor3f/rssalchemy/internal/sanitizer"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/labstack/gommon/log"
@@ -49,6 +50,17 @@ func main() {
 		log.Panicf("create nats adapter: %v", err)
 	}
 
+	archive, err := natsadapter.NewArchive(natsc)
+	if err != nil {
+		log.Panicf("create nats archive: %v", err)
+	}
+
+	sanitizerPolicy := sanitizer.DefaultPolicy()
+	if err := sanitizerPolicy.Allow(cfg.SanitizerAllow); err != nil {
+		log.Panicf("sanitizer allow: %v", err)
+	}
+	sanitizerPolicy.Deny(cfg.SanitizerDeny)
+
 	e := echo.New()
 	e.Use(middleware.Logger())
 	if !cfg.Debug {
@@ -66,6 +78,8 @@ func main() {
 	apiHandler := httpApi.New(
 		na,
 		na,
+		archive,
+		sanitizer.New(sanitizerPolicy),
 		rate.Every(time.Duration(float64(time.Second)*cfg.TaskRateLimitEvery)),
 		cfg.TaskRateLimitBurst,
 		cfg.Debug,
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/egor3f/rssalchemy/internal/adapters/natsadapter"
+	natsarticlecache "github.com/egor3f/rssalchemy/internal/articlecache/nats"
 	"github.com/egor3f/rssalchemy/internal/config"
 	natscookies "github.com/egor3f/rssalchemy/internal/cookiemgr/nats"
 	"github.com/egor3f/rssalchemy/internal/dateparser"
 	"github.com/egor3f/rssalchemy/internal/extractors/pwextractor"
+	natsfirstseen "github.com/egor3f/rssalchemy/internal/firstseen/nats"
 	"github.com/egor3f/rssalchemy/internal/limiter/redisleaky"
 	"github.com/egor3f/rssalchemy/internal/models"
 	"github.com/labstack/gommon/log"
@@ -58,6 +60,16 @@ func main() {
 		log.Panicf("create cookie manager: %v", err)
 	}
 
+	firstSeenStore, err := natsfirstseen.New(natsc)
+	if err != nil {
+		log.Panicf("create first seen store: %v", err)
+	}
+
+	articleCache, err := natsarticlecache.New(natsc)
+	if err != nil {
+		log.Panicf("create article cache: %v", err)
+	}
+
 	redisClient := redis.NewClient(&redis.Options{
 		Addr: cfg.RedisUrl,
 	})
@@ -82,8 +94,10 @@ func main() {
 		DateParser: &dateparser.DateParser{
 			CurrentTimeFunc: time.Now,
 		},
-		CookieManager: cookieManager,
-		Limiter:       perDomainLimiter,
+		CookieManager:  cookieManager,
+		FirstSeenStore: firstSeenStore,
+		ArticleCache:   articleCache,
+		Limiter:        perDomainLimiter,
 	})
 	if err != nil {
 		log.Panicf("create pw extractor: %v", err)
@@ -103,9 +117,24 @@ func main() {
 		var result any
 		switch task.TaskType {
 		case models.TaskTypeExtract:
-			result, err = pwe.Extract(task)
+			switch task.RenderMode {
+			case models.RenderMode_Static:
+				result, err = pwe.ExtractStatic(task)
+			case models.RenderMode_Auto:
+				result, err = pwe.ExtractAuto(task)
+			default:
+				result, err = pwe.Extract(task)
+			}
+		case models.TaskTypeJsonApi:
+			result, err = pwe.ExtractJson(task)
 		case models.TaskTypePageScreenshot:
 			result, err = pwe.Screenshot(task)
+		case models.TaskTypeSuggest:
+			result, err = pwe.SuggestSelectors(task)
+		case models.TaskTypePageSnapshot:
+			result, err = pwe.Snapshot(task)
+		case models.TaskTypeDebug:
+			result, err = pwe.DebugScreenshot(task)
 		}
 		if err != nil {
 			errRet = fmt.Errorf("task processing: %w", err)
@@ -14,7 +14,7 @@ export const useWizardStore = defineStore('wizard', () => {
 
   const formValid = computed(() => {
     return fields.every(field => (
-      !specs[field.name] && !(field as SpecField).required || field.validate(specs[field.name]!)
+      !specs[field.name] && !(field as SpecField).required || field.validate(specs[field.name]!, specs)
     ));
   });
 
@@ -26,7 +26,7 @@ export const useWizardStore = defineStore('wizard', () => {
     specs[fieldName] = newValue;
     updateLocalStorage();
   }
-  function updateSpecs(newValue: Specs) {
+  function updateSpecs(newValue: Partial<Specs>) {
     Object.assign(specs, newValue);
     updateLocalStorage();
   }
@@ -5,6 +5,9 @@ import {rssalchemy, rssalchemy as pb} from '@/urlmaker/proto/specs.ts';
 const apiBase = import.meta.env.VITE_API_BASE || document.location.origin;
 const renderEndpoint = '/api/v1/render/';  // trailing slash
 const screenshotEndpoint = '/api/v1/screenshot';  // no trailing slash
+const suggestEndpoint = '/api/v1/suggest';  // no trailing slash
+const snapshotEndpoint = '/api/v1/snapshot';  // no trailing slash
+const debugEndpoint = '/api/v1/debug/';  // trailing slash
 export const presetPrefix = 'rssalchemy:';
 
 export async function decodeUrl(url: string): Promise<Specs> {
@@ -69,3 +72,74 @@ export async function encodeSpecsPart(specs: Specs): Promise<string> {
 export function getScreenshotUrl(url: string): string {
   return `${apiBase}${screenshotEndpoint}?url=${encodeURIComponent(url)}`;
 }
+
+// getDebugScreenshotUrl is png with outlined matches of post and field selectors
+export async function getDebugScreenshotUrl(specs: Specs): Promise<string> {
+  return `${apiBase}${debugEndpoint}${await encodeSpecsPart(specs)}?format=png`;
+}
+
+export interface PreviewItem {
+  title: string
+  link: string
+  description?: string
+  created: string
+  enclosure?: string
+}
+
+export interface Suggestion {
+  score: number
+  specs: Specs
+  preview: PreviewItem[]
+}
+
+// Fields of suggested specs which are applied to form, other fields keep current values.
+// Source and structured data are reset, otherwise selector fields are hidden
+const suggestedFields: (keyof Specs)[] = [
+  'source_type', 'structured_data',
+  'selector_post', 'selector_post_dialect',
+  'selector_title', 'selector_title_dialect', 'title_extract_from', 'title_attribute_name',
+  'selector_link', 'selector_link_dialect', 'link_extract_from', 'link_attribute_name',
+  'selector_description', 'selector_description_dialect', 'description_extract_from', 'description_attribute_name',
+  'selector_created', 'selector_created_dialect', 'created_extract_from', 'created_attribute_name',
+  'selector_enclosure', 'selector_enclosure_dialect', 'enclosure_extract_from', 'enclosure_attribute_name',
+];
+
+export async function getSuggestions(url: string): Promise<Suggestion[]> {
+  const resp = await fetch(`${apiBase}${suggestEndpoint}?url=${encodeURIComponent(url)}`);
+  if (!resp.ok) {
+    throw `${resp.status} ${await resp.text()}`;
+  }
+  return resp.json();
+}
+
+export function suggestedSpecs(suggestion: Suggestion): Partial<Specs> {
+  return Object.fromEntries(suggestedFields.map(field => [field, suggestion.specs[field]]));
+}
+
+export interface SnapshotElement {
+  tag: string
+  id?: string
+  classes: string[]
+  text: string
+  x: number
+  y: number
+  width: number
+  height: number
+  selector: string  // matches only this element
+  general_selector: string  // matches similar elements
+}
+
+export interface Snapshot {
+  image: string  // data url
+  width: number
+  height: number
+  elements: SnapshotElement[]
+}
+
+export async function getSnapshot(url: string): Promise<Snapshot> {
+  const resp = await fetch(`${apiBase}${snapshotEndpoint}?url=${encodeURIComponent(url)}`);
+  if (!resp.ok) {
+    throw `${resp.status} ${await resp.text()}`;
+  }
+  return resp.json();
+}
@@ -7,7 +7,562 @@ import * as pb_1 from "google-protobuf";
 export namespace rssalchemy {
     export enum ExtractFrom {
         InnerText = 0,
-        Attribute = 1
+        Attribute = 1,
+        InnerHtml = 2,
+        TextContent = 3
+    }
+    export enum SelectorDialect {
+        Css = 0,
+        XPath = 1,
+        Playwright = 2
+    }
+    export enum FeedFormat {
+        Atom = 0,
+        Rss = 1,
+        JsonFeed = 2
+    }
+    export enum IdStrategy {
+        LinkDate = 0,
+        LinkHash = 1,
+        Selector = 2,
+        FieldsHash = 3
+    }
+    export enum FullTextMode {
+        Off = 0,
+        BySelector = 1,
+        Auto = 2
+    }
+    export enum RenderMode {
+        Browser = 0,
+        Static = 1,
+        StaticOrBrowser = 2
+    }
+    export enum SourceType {
+        Page = 0,
+        JsonApi = 1
+    }
+    export enum StructuredDataMode {
+        StructuredDataOff = 0,
+        StructuredDataFallback = 1,
+        StructuredDataOnly = 2
+    }
+    export enum JsonQueryLanguage {
+        JsonPath = 0,
+        JmesPath = 1
+    }
+    export enum HttpMethod {
+        Get = 0,
+        Post = 1
+    }
+    export enum ActionType {
+        Click = 0,
+        Fill = 1,
+        Press = 2,
+        WaitForSelector = 3,
+        WaitForTimeout = 4,
+        SelectOption = 5
+    }
+    export enum FilterField {
+        Title = 0,
+        Description = 1,
+        Content = 2,
+        Author = 3,
+        Link = 4
+    }
+    export enum FilterMatch {
+        Substring = 0,
+        Regex = 1
+    }
+    export enum FilterMode {
+        All = 0,
+        Any = 1
+    }
+    export enum TransformType {
+        RegexExtract = 0,
+        RegexReplace = 1,
+        Trim = 2,
+        Lowercase = 3,
+        Uppercase = 4,
+        Prefix = 5,
+        Suffix = 6,
+        Template = 7
+    }
+    export class Action extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            type?: ActionType;
+            selector?: string;
+            value?: string;
+            timeout_ms?: number;
+            optional?: boolean;
+            selector_dialect?: SelectorDialect;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                if ("type" in data && data.type != undefined) {
+                    this.type = data.type;
+                }
+                if ("selector" in data && data.selector != undefined) {
+                    this.selector = data.selector;
+                }
+                if ("value" in data && data.value != undefined) {
+                    this.value = data.value;
+                }
+                if ("timeout_ms" in data && data.timeout_ms != undefined) {
+                    this.timeout_ms = data.timeout_ms;
+                }
+                if ("optional" in data && data.optional != undefined) {
+                    this.optional = data.optional;
+                }
+                if ("selector_dialect" in data && data.selector_dialect != undefined) {
+                    this.selector_dialect = data.selector_dialect;
+                }
+            }
+        }
+        get type() {
+            return pb_1.Message.getFieldWithDefault(this, 1, ActionType.Click) as ActionType;
+        }
+        set type(value: ActionType) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get selector() {
+            return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+        }
+        set selector(value: string) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get value() {
+            return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+        }
+        set value(value: string) {
+            pb_1.Message.setField(this, 3, value);
+        }
+        get timeout_ms() {
+            return pb_1.Message.getFieldWithDefault(this, 4, 0) as number;
+        }
+        set timeout_ms(value: number) {
+            pb_1.Message.setField(this, 4, value);
+        }
+        get optional() {
+            return pb_1.Message.getFieldWithDefault(this, 5, false) as boolean;
+        }
+        set optional(value: boolean) {
+            pb_1.Message.setField(this, 5, value);
+        }
+        get selector_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 6, SelectorDialect.Css) as SelectorDialect;
+        }
+        set selector_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 6, value);
+        }
+        static fromObject(data: {
+            type?: ActionType;
+            selector?: string;
+            value?: string;
+            timeout_ms?: number;
+            optional?: boolean;
+            selector_dialect?: SelectorDialect;
+        }): Action {
+            const message = new Action({});
+            if (data.type != null) {
+                message.type = data.type;
+            }
+            if (data.selector != null) {
+                message.selector = data.selector;
+            }
+            if (data.value != null) {
+                message.value = data.value;
+            }
+            if (data.timeout_ms != null) {
+                message.timeout_ms = data.timeout_ms;
+            }
+            if (data.optional != null) {
+                message.optional = data.optional;
+            }
+            if (data.selector_dialect != null) {
+                message.selector_dialect = data.selector_dialect;
+            }
+            return message;
+        }
+        toObject() {
+            const data: {
+                type?: ActionType;
+                selector?: string;
+                value?: string;
+                timeout_ms?: number;
+                optional?: boolean;
+                selector_dialect?: SelectorDialect;
+            } = {};
+            if (this.type != null) {
+                data.type = this.type;
+            }
+            if (this.selector != null) {
+                data.selector = this.selector;
+            }
+            if (this.value != null) {
+                data.value = this.value;
+            }
+            if (this.timeout_ms != null) {
+                data.timeout_ms = this.timeout_ms;
+            }
+            if (this.optional != null) {
+                data.optional = this.optional;
+            }
+            if (this.selector_dialect != null) {
+                data.selector_dialect = this.selector_dialect;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.type != ActionType.Click)
+                writer.writeEnum(1, this.type);
+            if (this.selector.length)
+                writer.writeString(2, this.selector);
+            if (this.value.length)
+                writer.writeString(3, this.value);
+            if (this.timeout_ms != 0)
+                writer.writeInt32(4, this.timeout_ms);
+            if (this.optional != false)
+                writer.writeBool(5, this.optional);
+            if (this.selector_dialect != SelectorDialect.Css)
+                writer.writeEnum(6, this.selector_dialect);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Action {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Action();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.type = reader.readEnum();
+                        break;
+                    case 2:
+                        message.selector = reader.readString();
+                        break;
+                    case 3:
+                        message.value = reader.readString();
+                        break;
+                    case 4:
+                        message.timeout_ms = reader.readInt32();
+                        break;
+                    case 5:
+                        message.optional = reader.readBool();
+                        break;
+                    case 6:
+                        message.selector_dialect = reader.readEnum();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Action {
+            return Action.deserialize(bytes);
+        }
+    }
+    export class Filter extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            field?: FilterField;
+            match?: FilterMatch;
+            pattern?: string;
+            exclude?: boolean;
+            case_sensitive?: boolean;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                if ("field" in data && data.field != undefined) {
+                    this.field = data.field;
+                }
+                if ("match" in data && data.match != undefined) {
+                    this.match = data.match;
+                }
+                if ("pattern" in data && data.pattern != undefined) {
+                    this.pattern = data.pattern;
+                }
+                if ("exclude" in data && data.exclude != undefined) {
+                    this.exclude = data.exclude;
+                }
+                if ("case_sensitive" in data && data.case_sensitive != undefined) {
+                    this.case_sensitive = data.case_sensitive;
+                }
+            }
+        }
+        get field() {
+            return pb_1.Message.getFieldWithDefault(this, 1, FilterField.Title) as FilterField;
+        }
+        set field(value: FilterField) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get match() {
+            return pb_1.Message.getFieldWithDefault(this, 2, FilterMatch.Substring) as FilterMatch;
+        }
+        set match(value: FilterMatch) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get pattern() {
+            return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+        }
+        set pattern(value: string) {
+            pb_1.Message.setField(this, 3, value);
+        }
+        get exclude() {
+            return pb_1.Message.getFieldWithDefault(this, 4, false) as boolean;
+        }
+        set exclude(value: boolean) {
+            pb_1.Message.setField(this, 4, value);
+        }
+        get case_sensitive() {
+            return pb_1.Message.getFieldWithDefault(this, 5, false) as boolean;
+        }
+        set case_sensitive(value: boolean) {
+            pb_1.Message.setField(this, 5, value);
+        }
+        static fromObject(data: {
+            field?: FilterField;
+            match?: FilterMatch;
+            pattern?: string;
+            exclude?: boolean;
+            case_sensitive?: boolean;
+        }): Filter {
+            const message = new Filter({});
+            if (data.field != null) {
+                message.field = data.field;
+            }
+            if (data.match != null) {
+                message.match = data.match;
+            }
+            if (data.pattern != null) {
+                message.pattern = data.pattern;
+            }
+            if (data.exclude != null) {
+                message.exclude = data.exclude;
+            }
+            if (data.case_sensitive != null) {
+                message.case_sensitive = data.case_sensitive;
+            }
+            return message;
+        }
+        toObject() {
+            const data: {
+                field?: FilterField;
+                match?: FilterMatch;
+                pattern?: string;
+                exclude?: boolean;
+                case_sensitive?: boolean;
+            } = {};
+            if (this.field != null) {
+                data.field = this.field;
+            }
+            if (this.match != null) {
+                data.match = this.match;
+            }
+            if (this.pattern != null) {
+                data.pattern = this.pattern;
+            }
+            if (this.exclude != null) {
+                data.exclude = this.exclude;
+            }
+            if (this.case_sensitive != null) {
+                data.case_sensitive = this.case_sensitive;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.field != FilterField.Title)
+                writer.writeEnum(1, this.field);
+            if (this.match != FilterMatch.Substring)
+                writer.writeEnum(2, this.match);
+            if (this.pattern.length)
+                writer.writeString(3, this.pattern);
+            if (this.exclude != false)
+                writer.writeBool(4, this.exclude);
+            if (this.case_sensitive != false)
+                writer.writeBool(5, this.case_sensitive);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Filter {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Filter();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.field = reader.readEnum();
+                        break;
+                    case 2:
+                        message.match = reader.readEnum();
+                        break;
+                    case 3:
+                        message.pattern = reader.readString();
+                        break;
+                    case 4:
+                        message.exclude = reader.readBool();
+                        break;
+                    case 5:
+                        message.case_sensitive = reader.readBool();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Filter {
+            return Filter.deserialize(bytes);
+        }
+    }
+    export class Transform extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            field?: string;
+            type?: TransformType;
+            pattern?: string;
+            value?: string;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                if ("field" in data && data.field != undefined) {
+                    this.field = data.field;
+                }
+                if ("type" in data && data.type != undefined) {
+                    this.type = data.type;
+                }
+                if ("pattern" in data && data.pattern != undefined) {
+                    this.pattern = data.pattern;
+                }
+                if ("value" in data && data.value != undefined) {
+                    this.value = data.value;
+                }
+            }
+        }
+        get field() {
+            return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+        }
+        set field(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get type() {
+            return pb_1.Message.getFieldWithDefault(this, 2, TransformType.RegexExtract) as TransformType;
+        }
+        set type(value: TransformType) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get pattern() {
+            return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+        }
+        set pattern(value: string) {
+            pb_1.Message.setField(this, 3, value);
+        }
+        get value() {
+            return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+        }
+        set value(value: string) {
+            pb_1.Message.setField(this, 4, value);
+        }
+        static fromObject(data: {
+            field?: string;
+            type?: TransformType;
+            pattern?: string;
+            value?: string;
+        }): Transform {
+            const message = new Transform({});
+            if (data.field != null) {
+                message.field = data.field;
+            }
+            if (data.type != null) {
+                message.type = data.type;
+            }
+            if (data.pattern != null) {
+                message.pattern = data.pattern;
+            }
+            if (data.value != null) {
+                message.value = data.value;
+            }
+            return message;
+        }
+        toObject() {
+            const data: {
+                field?: string;
+                type?: TransformType;
+                pattern?: string;
+                value?: string;
+            } = {};
+            if (this.field != null) {
+                data.field = this.field;
+            }
+            if (this.type != null) {
+                data.type = this.type;
+            }
+            if (this.pattern != null) {
+                data.pattern = this.pattern;
+            }
+            if (this.value != null) {
+                data.value = this.value;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.field.length)
+                writer.writeString(1, this.field);
+            if (this.type != TransformType.RegexExtract)
+                writer.writeEnum(2, this.type);
+            if (this.pattern.length)
+                writer.writeString(3, this.pattern);
+            if (this.value.length)
+                writer.writeString(4, this.value);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Transform {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Transform();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.field = reader.readString();
+                        break;
+                    case 2:
+                        message.type = reader.readEnum();
+                        break;
+                    case 3:
+                        message.pattern = reader.readString();
+                        break;
+                    case 4:
+                        message.value = reader.readString();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Transform {
+            return Transform.deserialize(bytes);
+        }
     }
     export class Specs extends pb_1.Message {
         #one_of_decls: number[][] = [];
@@ -24,9 +579,69 @@ export namespace rssalchemy {
             selector_content?: string;
             selector_enclosure?: string;
             cache_lifetime?: string;
+            format?: FeedFormat;
+            archive_items?: number;
+            archive_days?: number;
+            id_strategy?: IdStrategy;
+            selector_id?: string;
+            id_attribute_name?: string;
+            id_fields?: string[];
+            feed_title?: string;
+            feed_subtitle?: string;
+            fulltext_mode?: FullTextMode;
+            selector_fulltext?: string;
+            selector_next_page?: string;
+            max_pages?: number;
+            scroll_steps?: number;
+            selector_load_more?: string;
+            target_items?: number;
+            actions?: Action[];
+            filters?: Filter[];
+            filter_mode?: FilterMode;
+            transforms?: Transform[];
+            title_extract_from?: ExtractFrom;
+            title_attribute_name?: string;
+            link_extract_from?: ExtractFrom;
+            link_attribute_name?: string;
+            description_extract_from?: ExtractFrom;
+            description_attribute_name?: string;
+            author_extract_from?: ExtractFrom;
+            author_attribute_name?: string;
+            enclosure_extract_from?: ExtractFrom;
+            enclosure_attribute_name?: string;
+            selector_updated?: string;
+            updated_extract_from?: ExtractFrom;
+            updated_attribute_name?: string;
+            selector_post_dialect?: SelectorDialect;
+            selector_title_dialect?: SelectorDialect;
+            selector_link_dialect?: SelectorDialect;
+            selector_description_dialect?: SelectorDialect;
+            selector_author_dialect?: SelectorDialect;
+            selector_created_dialect?: SelectorDialect;
+            selector_content_dialect?: SelectorDialect;
+            selector_enclosure_dialect?: SelectorDialect;
+            selector_id_dialect?: SelectorDialect;
+            selector_fulltext_dialect?: SelectorDialect;
+            selector_next_page_dialect?: SelectorDialect;
+            selector_load_more_dialect?: SelectorDialect;
+            selector_updated_dialect?: SelectorDialect;
+            render_mode?: RenderMode;
+            source_type?: SourceType;
+            json_method?: HttpMethod;
+            json_body?: string;
+            json_query_language?: JsonQueryLanguage;
+            json_items?: string;
+            json_title?: string;
+            json_link?: string;
+            json_description?: string;
+            json_content?: string;
+            json_author?: string;
+            json_created?: string;
+            json_enclosure?: string;
+            structured_data?: StructuredDataMode;
         }) {
             super();
-            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [19, 29, 30, 32], this.#one_of_decls);
             if (!Array.isArray(data) && typeof data == "object") {
                 if ("url" in data && data.url != undefined) {
                     this.url = data.url;
@@ -64,79 +679,619 @@ export namespace rssalchemy {
                 if ("cache_lifetime" in data && data.cache_lifetime != undefined) {
                     this.cache_lifetime = data.cache_lifetime;
                 }
+                if ("format" in data && data.format != undefined) {
+                    this.format = data.format;
+                }
+                if ("archive_items" in data && data.archive_items != undefined) {
+                    this.archive_items = data.archive_items;
+                }
+                if ("archive_days" in data && data.archive_days != undefined) {
+                    this.archive_days = data.archive_days;
+                }
+                if ("id_strategy" in data && data.id_strategy != undefined) {
+                    this.id_strategy = data.id_strategy;
+                }
+                if ("selector_id" in data && data.selector_id != undefined) {
+                    this.selector_id = data.selector_id;
+                }
+                if ("id_attribute_name" in data && data.id_attribute_name != undefined) {
+                    this.id_attribute_name = data.id_attribute_name;
+                }
+                if ("id_fields" in data && data.id_fields != undefined) {
+                    this.id_fields = data.id_fields;
+                }
+                if ("feed_title" in data && data.feed_title != undefined) {
+                    this.feed_title = data.feed_title;
+                }
+                if ("feed_subtitle" in data && data.feed_subtitle != undefined) {
+                    this.feed_subtitle = data.feed_subtitle;
+                }
+                if ("fulltext_mode" in data && data.fulltext_mode != undefined) {
+                    this.fulltext_mode = data.fulltext_mode;
+                }
+                if ("selector_fulltext" in data && data.selector_fulltext != undefined) {
+                    this.selector_fulltext = data.selector_fulltext;
+                }
+                if ("selector_next_page" in data && data.selector_next_page != undefined) {
+                    this.selector_next_page = data.selector_next_page;
+                }
+                if ("max_pages" in data && data.max_pages != undefined) {
+                    this.max_pages = data.max_pages;
+                }
+                if ("scroll_steps" in data && data.scroll_steps != undefined) {
+                    this.scroll_steps = data.scroll_steps;
+                }
+                if ("selector_load_more" in data && data.selector_load_more != undefined) {
+                    this.selector_load_more = data.selector_load_more;
+                }
+                if ("target_items" in data && data.target_items != undefined) {
+                    this.target_items = data.target_items;
+                }
+                if ("actions" in data && data.actions != undefined) {
+                    this.actions = data.actions;
+                }
+                if ("filters" in data && data.filters != undefined) {
+                    this.filters = data.filters;
+                }
+                if ("filter_mode" in data && data.filter_mode != undefined) {
+                    this.filter_mode = data.filter_mode;
+                }
+                if ("transforms" in data && data.transforms != undefined) {
+                    this.transforms = data.transforms;
+                }
+                if ("title_extract_from" in data && data.title_extract_from != undefined) {
+                    this.title_extract_from = data.title_extract_from;
+                }
+                if ("title_attribute_name" in data && data.title_attribute_name != undefined) {
+                    this.title_attribute_name = data.title_attribute_name;
+                }
+                if ("link_extract_from" in data && data.link_extract_from != undefined) {
+                    this.link_extract_from = data.link_extract_from;
+                }
+                if ("link_attribute_name" in data && data.link_attribute_name != undefined) {
+                    this.link_attribute_name = data.link_attribute_name;
+                }
+                if ("description_extract_from" in data && data.description_extract_from != undefined) {
+                    this.description_extract_from = data.description_extract_from;
+                }
+                if ("description_attribute_name" in data && data.description_attribute_name != undefined) {
+                    this.description_attribute_name = data.description_attribute_name;
+                }
+                if ("author_extract_from" in data && data.author_extract_from != undefined) {
+                    this.author_extract_from = data.author_extract_from;
+                }
+                if ("author_attribute_name" in data && data.author_attribute_name != undefined) {
+                    this.author_attribute_name = data.author_attribute_name;
+                }
+                if ("enclosure_extract_from" in data && data.enclosure_extract_from != undefined) {
+                    this.enclosure_extract_from = data.enclosure_extract_from;
+                }
+                if ("enclosure_attribute_name" in data && data.enclosure_attribute_name != undefined) {
+                    this.enclosure_attribute_name = data.enclosure_attribute_name;
+                }
+                if ("selector_updated" in data && data.selector_updated != undefined) {
+                    this.selector_updated = data.selector_updated;
+                }
+                if ("updated_extract_from" in data && data.updated_extract_from != undefined) {
+                    this.updated_extract_from = data.updated_extract_from;
+                }
+                if ("updated_attribute_name" in data && data.updated_attribute_name != undefined) {
+                    this.updated_attribute_name = data.updated_attribute_name;
+                }
+                if ("selector_post_dialect" in data && data.selector_post_dialect != undefined) {
+                    this.selector_post_dialect = data.selector_post_dialect;
+                }
+                if ("selector_title_dialect" in data && data.selector_title_dialect != undefined) {
+                    this.selector_title_dialect = data.selector_title_dialect;
+                }
+                if ("selector_link_dialect" in data && data.selector_link_dialect != undefined) {
+                    this.selector_link_dialect = data.selector_link_dialect;
+                }
+                if ("selector_description_dialect" in data && data.selector_description_dialect != undefined) {
+                    this.selector_description_dialect = data.selector_description_dialect;
+                }
+                if ("selector_author_dialect" in data && data.selector_author_dialect != undefined) {
+                    this.selector_author_dialect = data.selector_author_dialect;
+                }
+                if ("selector_created_dialect" in data && data.selector_created_dialect != undefined) {
+                    this.selector_created_dialect = data.selector_created_dialect;
+                }
+                if ("selector_content_dialect" in data && data.selector_content_dialect != undefined) {
+                    this.selector_content_dialect = data.selector_content_dialect;
+                }
+                if ("selector_enclosure_dialect" in data && data.selector_enclosure_dialect != undefined) {
+                    this.selector_enclosure_dialect = data.selector_enclosure_dialect;
+                }
+                if ("selector_id_dialect" in data && data.selector_id_dialect != undefined) {
+                    this.selector_id_dialect = data.selector_id_dialect;
+                }
+                if ("selector_fulltext_dialect" in data && data.selector_fulltext_dialect != undefined) {
+                    this.selector_fulltext_dialect = data.selector_fulltext_dialect;
+                }
+                if ("selector_next_page_dialect" in data && data.selector_next_page_dialect != undefined) {
+                    this.selector_next_page_dialect = data.selector_next_page_dialect;
+                }
+                if ("selector_load_more_dialect" in data && data.selector_load_more_dialect != undefined) {
+                    this.selector_load_more_dialect = data.selector_load_more_dialect;
+                }
+                if ("selector_updated_dialect" in data && data.selector_updated_dialect != undefined) {
+                    this.selector_updated_dialect = data.selector_updated_dialect;
+                }
+                if ("render_mode" in data && data.render_mode != undefined) {
+                    this.render_mode = data.render_mode;
+                }
+                if ("source_type" in data && data.source_type != undefined) {
+                    this.source_type = data.source_type;
+                }
+                if ("json_method" in data && data.json_method != undefined) {
+                    this.json_method = data.json_method;
+                }
+                if ("json_body" in data && data.json_body != undefined) {
+                    this.json_body = data.json_body;
+                }
+                if ("json_query_language" in data && data.json_query_language != undefined) {
+                    this.json_query_language = data.json_query_language;
+                }
+                if ("json_items" in data && data.json_items != undefined) {
+                    this.json_items = data.json_items;
+                }
+                if ("json_title" in data && data.json_title != undefined) {
+                    this.json_title = data.json_title;
+                }
+                if ("json_link" in data && data.json_link != undefined) {
+                    this.json_link = data.json_link;
+                }
+                if ("json_description" in data && data.json_description != undefined) {
+                    this.json_description = data.json_description;
+                }
+                if ("json_content" in data && data.json_content != undefined) {
+                    this.json_content = data.json_content;
+                }
+                if ("json_author" in data && data.json_author != undefined) {
+                    this.json_author = data.json_author;
+                }
+                if ("json_created" in data && data.json_created != undefined) {
+                    this.json_created = data.json_created;
+                }
+                if ("json_enclosure" in data && data.json_enclosure != undefined) {
+                    this.json_enclosure = data.json_enclosure;
+                }
+                if ("structured_data" in data && data.structured_data != undefined) {
+                    this.structured_data = data.structured_data;
+                }
             }
         }
-        get url() {
-            return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+        get url() {
+            return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+        }
+        set url(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get selector_post() {
+            return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+        }
+        set selector_post(value: string) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get selector_title() {
+            return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+        }
+        set selector_title(value: string) {
+            pb_1.Message.setField(this, 3, value);
+        }
+        get selector_link() {
+            return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+        }
+        set selector_link(value: string) {
+            pb_1.Message.setField(this, 4, value);
+        }
+        get selector_description() {
+            return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+        }
+        set selector_description(value: string) {
+            pb_1.Message.setField(this, 5, value);
+        }
+        get selector_author() {
+            return pb_1.Message.getFieldWithDefault(this, 6, "") as string;
+        }
+        set selector_author(value: string) {
+            pb_1.Message.setField(this, 6, value);
+        }
+        get selector_created() {
+            return pb_1.Message.getFieldWithDefault(this, 7, "") as string;
+        }
+        set selector_created(value: string) {
+            pb_1.Message.setField(this, 7, value);
+        }
+        get created_extract_from() {
+            return pb_1.Message.getFieldWithDefault(this, 11, ExtractFrom.InnerText) as ExtractFrom;
+        }
+        set created_extract_from(value: ExtractFrom) {
+            pb_1.Message.setField(this, 11, value);
+        }
+        get created_attribute_name() {
+            return pb_1.Message.getFieldWithDefault(this, 12, "") as string;
+        }
+        set created_attribute_name(value: string) {
+            pb_1.Message.setField(this, 12, value);
+        }
+        get selector_content() {
+            return pb_1.Message.getFieldWithDefault(this, 8, "") as string;
+        }
+        set selector_content(value: string) {
+            pb_1.Message.setField(this, 8, value);
+        }
+        get selector_enclosure() {
+            return pb_1.Message.getFieldWithDefault(this, 9, "") as string;
+        }
+        set selector_enclosure(value: string) {
+            pb_1.Message.setField(this, 9, value);
+        }
+        get cache_lifetime() {
+            return pb_1.Message.getFieldWithDefault(this, 10, "") as string;
+        }
+        set cache_lifetime(value: string) {
+            pb_1.Message.setField(this, 10, value);
+        }
+        get format() {
+            return pb_1.Message.getFieldWithDefault(this, 13, FeedFormat.Atom) as FeedFormat;
+        }
+        set format(value: FeedFormat) {
+            pb_1.Message.setField(this, 13, value);
+        }
+        get archive_items() {
+            return pb_1.Message.getFieldWithDefault(this, 14, 0) as number;
+        }
+        set archive_items(value: number) {
+            pb_1.Message.setField(this, 14, value);
+        }
+        get archive_days() {
+            return pb_1.Message.getFieldWithDefault(this, 15, 0) as number;
+        }
+        set archive_days(value: number) {
+            pb_1.Message.setField(this, 15, value);
+        }
+        get id_strategy() {
+            return pb_1.Message.getFieldWithDefault(this, 16, IdStrategy.LinkDate) as IdStrategy;
+        }
+        set id_strategy(value: IdStrategy) {
+            pb_1.Message.setField(this, 16, value);
+        }
+        get selector_id() {
+            return pb_1.Message.getFieldWithDefault(this, 17, "") as string;
+        }
+        set selector_id(value: string) {
+            pb_1.Message.setField(this, 17, value);
+        }
+        get id_attribute_name() {
+            return pb_1.Message.getFieldWithDefault(this, 18, "") as string;
+        }
+        set id_attribute_name(value: string) {
+            pb_1.Message.setField(this, 18, value);
+        }
+        get id_fields() {
+            return pb_1.Message.getFieldWithDefault(this, 19, []) as string[];
+        }
+        set id_fields(value: string[]) {
+            pb_1.Message.setField(this, 19, value);
+        }
+        get feed_title() {
+            return pb_1.Message.getFieldWithDefault(this, 20, "") as string;
+        }
+        set feed_title(value: string) {
+            pb_1.Message.setField(this, 20, value);
+        }
+        get feed_subtitle() {
+            return pb_1.Message.getFieldWithDefault(this, 21, "") as string;
+        }
+        set feed_subtitle(value: string) {
+            pb_1.Message.setField(this, 21, value);
+        }
+        get fulltext_mode() {
+            return pb_1.Message.getFieldWithDefault(this, 22, FullTextMode.Off) as FullTextMode;
+        }
+        set fulltext_mode(value: FullTextMode) {
+            pb_1.Message.setField(this, 22, value);
+        }
+        get selector_fulltext() {
+            return pb_1.Message.getFieldWithDefault(this, 23, "") as string;
+        }
+        set selector_fulltext(value: string) {
+            pb_1.Message.setField(this, 23, value);
+        }
+        get selector_next_page() {
+            return pb_1.Message.getFieldWithDefault(this, 24, "") as string;
+        }
+        set selector_next_page(value: string) {
+            pb_1.Message.setField(this, 24, value);
+        }
+        get max_pages() {
+            return pb_1.Message.getFieldWithDefault(this, 25, 0) as number;
+        }
+        set max_pages(value: number) {
+            pb_1.Message.setField(this, 25, value);
+        }
+        get scroll_steps() {
+            return pb_1.Message.getFieldWithDefault(this, 26, 0) as number;
+        }
+        set scroll_steps(value: number) {
+            pb_1.Message.setField(this, 26, value);
+        }
+        get selector_load_more() {
+            return pb_1.Message.getFieldWithDefault(this, 27, "") as string;
+        }
+        set selector_load_more(value: string) {
+            pb_1.Message.setField(this, 27, value);
+        }
+        get target_items() {
+            return pb_1.Message.getFieldWithDefault(this, 28, 0) as number;
+        }
+        set target_items(value: number) {
+            pb_1.Message.setField(this, 28, value);
+        }
+        get actions() {
+            return pb_1.Message.getRepeatedWrapperField(this, Action, 29) as Action[];
+        }
+        set actions(value: Action[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 29, value);
+        }
+        get filters() {
+            return pb_1.Message.getRepeatedWrapperField(this, Filter, 30) as Filter[];
+        }
+        set filters(value: Filter[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 30, value);
+        }
+        get filter_mode() {
+            return pb_1.Message.getFieldWithDefault(this, 31, FilterMode.All) as FilterMode;
+        }
+        set filter_mode(value: FilterMode) {
+            pb_1.Message.setField(this, 31, value);
+        }
+        get transforms() {
+            return pb_1.Message.getRepeatedWrapperField(this, Transform, 32) as Transform[];
+        }
+        set transforms(value: Transform[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 32, value);
+        }
+        get title_extract_from() {
+            return pb_1.Message.getFieldWithDefault(this, 33, ExtractFrom.InnerText) as ExtractFrom;
+        }
+        set title_extract_from(value: ExtractFrom) {
+            pb_1.Message.setField(this, 33, value);
+        }
+        get title_attribute_name() {
+            return pb_1.Message.getFieldWithDefault(this, 34, "") as string;
+        }
+        set title_attribute_name(value: string) {
+            pb_1.Message.setField(this, 34, value);
+        }
+        get link_extract_from() {
+            return pb_1.Message.getFieldWithDefault(this, 35, ExtractFrom.InnerText) as ExtractFrom;
+        }
+        set link_extract_from(value: ExtractFrom) {
+            pb_1.Message.setField(this, 35, value);
+        }
+        get link_attribute_name() {
+            return pb_1.Message.getFieldWithDefault(this, 36, "") as string;
+        }
+        set link_attribute_name(value: string) {
+            pb_1.Message.setField(this, 36, value);
+        }
+        get description_extract_from() {
+            return pb_1.Message.getFieldWithDefault(this, 37, ExtractFrom.InnerText) as ExtractFrom;
+        }
+        set description_extract_from(value: ExtractFrom) {
+            pb_1.Message.setField(this, 37, value);
+        }
+        get description_attribute_name() {
+            return pb_1.Message.getFieldWithDefault(this, 38, "") as string;
+        }
+        set description_attribute_name(value: string) {
+            pb_1.Message.setField(this, 38, value);
+        }
+        get author_extract_from() {
+            return pb_1.Message.getFieldWithDefault(this, 39, ExtractFrom.InnerText) as ExtractFrom;
+        }
+        set author_extract_from(value: ExtractFrom) {
+            pb_1.Message.setField(this, 39, value);
+        }
+        get author_attribute_name() {
+            return pb_1.Message.getFieldWithDefault(this, 40, "") as string;
+        }
+        set author_attribute_name(value: string) {
+            pb_1.Message.setField(this, 40, value);
+        }
+        get enclosure_extract_from() {
+            return pb_1.Message.getFieldWithDefault(this, 41, ExtractFrom.InnerText) as ExtractFrom;
+        }
+        set enclosure_extract_from(value: ExtractFrom) {
+            pb_1.Message.setField(this, 41, value);
+        }
+        get enclosure_attribute_name() {
+            return pb_1.Message.getFieldWithDefault(this, 42, "") as string;
+        }
+        set enclosure_attribute_name(value: string) {
+            pb_1.Message.setField(this, 42, value);
+        }
+        get selector_updated() {
+            return pb_1.Message.getFieldWithDefault(this, 43, "") as string;
+        }
+        set selector_updated(value: string) {
+            pb_1.Message.setField(this, 43, value);
+        }
+        get updated_extract_from() {
+            return pb_1.Message.getFieldWithDefault(this, 44, ExtractFrom.InnerText) as ExtractFrom;
+        }
+        set updated_extract_from(value: ExtractFrom) {
+            pb_1.Message.setField(this, 44, value);
+        }
+        get updated_attribute_name() {
+            return pb_1.Message.getFieldWithDefault(this, 45, "") as string;
+        }
+        set updated_attribute_name(value: string) {
+            pb_1.Message.setField(this, 45, value);
+        }
+        get selector_post_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 46, SelectorDialect.Css) as SelectorDialect;
+        }
+        set selector_post_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 46, value);
+        }
+        get selector_title_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 47, SelectorDialect.Css) as SelectorDialect;
         }
-        set url(value: string) {
-            pb_1.Message.setField(this, 1, value);
+        set selector_title_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 47, value);
         }
-        get selector_post() {
-            return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+        get selector_link_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 48, SelectorDialect.Css) as SelectorDialect;
         }
-        set selector_post(value: string) {
-            pb_1.Message.setField(this, 2, value);
+        set selector_link_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 48, value);
         }
-        get selector_title() {
-            return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+        get selector_description_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 49, SelectorDialect.Css) as SelectorDialect;
         }
-        set selector_title(value: string) {
-            pb_1.Message.setField(this, 3, value);
+        set selector_description_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 49, value);
         }
-        get selector_link() {
-            return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+        get selector_author_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 50, SelectorDialect.Css) as SelectorDialect;
         }
-        set selector_link(value: string) {
-            pb_1.Message.setField(this, 4, value);
+        set selector_author_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 50, value);
         }
-        get selector_description() {
-            return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+        get selector_created_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 51, SelectorDialect.Css) as SelectorDialect;
         }
-        set selector_description(value: string) {
-            pb_1.Message.setField(this, 5, value);
+        set selector_created_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 51, value);
         }
-        get selector_author() {
-            return pb_1.Message.getFieldWithDefault(this, 6, "") as string;
+        get selector_content_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 52, SelectorDialect.Css) as SelectorDialect;
         }
-        set selector_author(value: string) {
-            pb_1.Message.setField(this, 6, value);
+        set selector_content_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 52, value);
         }
-        get selector_created() {
-            return pb_1.Message.getFieldWithDefault(this, 7, "") as string;
+        get selector_enclosure_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 53, SelectorDialect.Css) as SelectorDialect;
         }
-        set selector_created(value: string) {
-            pb_1.Message.setField(this, 7, value);
+        set selector_enclosure_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 53, value);
         }
-        get created_extract_from() {
-            return pb_1.Message.getFieldWithDefault(this, 11, ExtractFrom.InnerText) as ExtractFrom;
+        get selector_id_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 54, SelectorDialect.Css) as SelectorDialect;
         }
-        set created_extract_from(value: ExtractFrom) {
-            pb_1.Message.setField(this, 11, value);
+        set selector_id_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 54, value);
         }
-        get created_attribute_name() {
-            return pb_1.Message.getFieldWithDefault(this, 12, "") as string;
+        get selector_fulltext_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 55, SelectorDialect.Css) as SelectorDialect;
         }
-        set created_attribute_name(value: string) {
-            pb_1.Message.setField(this, 12, value);
+        set selector_fulltext_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 55, value);
         }
-        get selector_content() {
-            return pb_1.Message.getFieldWithDefault(this, 8, "") as string;
+        get selector_next_page_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 56, SelectorDialect.Css) as SelectorDialect;
         }
-        set selector_content(value: string) {
-            pb_1.Message.setField(this, 8, value);
+        set selector_next_page_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 56, value);
         }
-        get selector_enclosure() {
-            return pb_1.Message.getFieldWithDefault(this, 9, "") as string;
+        get selector_load_more_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 57, SelectorDialect.Css) as SelectorDialect;
         }
-        set selector_enclosure(value: string) {
-            pb_1.Message.setField(this, 9, value);
+        set selector_load_more_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 57, value);
         }
-        get cache_lifetime() {
-            return pb_1.Message.getFieldWithDefault(this, 10, "") as string;
+        get selector_updated_dialect() {
+            return pb_1.Message.getFieldWithDefault(this, 58, SelectorDialect.Css) as SelectorDialect;
         }
-        set cache_lifetime(value: string) {
-            pb_1.Message.setField(this, 10, value);
+        set selector_updated_dialect(value: SelectorDialect) {
+            pb_1.Message.setField(this, 58, value);
+        }
+        get render_mode() {
+            return pb_1.Message.getFieldWithDefault(this, 59, RenderMode.Browser) as RenderMode;
+        }
+        set render_mode(value: RenderMode) {
+            pb_1.Message.setField(this, 59, value);
+        }
+        get source_type() {
+            return pb_1.Message.getFieldWithDefault(this, 60, SourceType.Page) as SourceType;
+        }
+        set source_type(value: SourceType) {
+            pb_1.Message.setField(this, 60, value);
+        }
+        get json_method() {
+            return pb_1.Message.getFieldWithDefault(this, 61, HttpMethod.Get) as HttpMethod;
+        }
+        set json_method(value: HttpMethod) {
+            pb_1.Message.setField(this, 61, value);
+        }
+        get json_body() {
+            return pb_1.Message.getFieldWithDefault(this, 62, "") as string;
+        }
+        set json_body(value: string) {
+            pb_1.Message.setField(this, 62, value);
+        }
+        get json_query_language() {
+            return pb_1.Message.getFieldWithDefault(this, 63, JsonQueryLanguage.JsonPath) as JsonQueryLanguage;
+        }
+        set json_query_language(value: JsonQueryLanguage) {
+            pb_1.Message.setField(this, 63, value);
+        }
+        get json_items() {
+            return pb_1.Message.getFieldWithDefault(this, 64, "") as string;
+        }
+        set json_items(value: string) {
+            pb_1.Message.setField(this, 64, value);
+        }
+        get json_title() {
+            return pb_1.Message.getFieldWithDefault(this, 65, "") as string;
+        }
+        set json_title(value: string) {
+            pb_1.Message.setField(this, 65, value);
+        }
+        get json_link() {
+            return pb_1.Message.getFieldWithDefault(this, 66, "") as string;
+        }
+        set json_link(value: string) {
+            pb_1.Message.setField(this, 66, value);
+        }
+        get json_description() {
+            return pb_1.Message.getFieldWithDefault(this, 67, "") as string;
+        }
+        set json_description(value: string) {
+            pb_1.Message.setField(this, 67, value);
+        }
+        get json_content() {
+            return pb_1.Message.getFieldWithDefault(this, 68, "") as string;
+        }
+        set json_content(value: string) {
+            pb_1.Message.setField(this, 68, value);
+        }
+        get json_author() {
+            return pb_1.Message.getFieldWithDefault(this, 69, "") as string;
+        }
+        set json_author(value: string) {
+            pb_1.Message.setField(this, 69, value);
+        }
+        get json_created() {
+            return pb_1.Message.getFieldWithDefault(this, 70, "") as string;
+        }
+        set json_created(value: string) {
+            pb_1.Message.setField(this, 70, value);
+        }
+        get json_enclosure() {
+            return pb_1.Message.getFieldWithDefault(this, 71, "") as string;
+        }
+        set json_enclosure(value: string) {
+            pb_1.Message.setField(this, 71, value);
+        }
+        get structured_data() {
+            return pb_1.Message.getFieldWithDefault(this, 72, StructuredDataMode.StructuredDataOff) as StructuredDataMode;
+        }
+        set structured_data(value: StructuredDataMode) {
+            pb_1.Message.setField(this, 72, value);
         }
         static fromObject(data: {
             url?: string;
@@ -151,6 +1306,66 @@ export namespace rssalchemy {
             selector_content?: string;
             selector_enclosure?: string;
             cache_lifetime?: string;
+            format?: FeedFormat;
+            archive_items?: number;
+            archive_days?: number;
+            id_strategy?: IdStrategy;
+            selector_id?: string;
+            id_attribute_name?: string;
+            id_fields?: string[];
+            feed_title?: string;
+            feed_subtitle?: string;
+            fulltext_mode?: FullTextMode;
+            selector_fulltext?: string;
+            selector_next_page?: string;
+            max_pages?: number;
+            scroll_steps?: number;
+            selector_load_more?: string;
+            target_items?: number;
+            actions?: ReturnType<typeof Action.prototype.toObject>[];
+            filters?: ReturnType<typeof Filter.prototype.toObject>[];
+            filter_mode?: FilterMode;
+            transforms?: ReturnType<typeof Transform.prototype.toObject>[];
+            title_extract_from?: ExtractFrom;
+            title_attribute_name?: string;
+            link_extract_from?: ExtractFrom;
+            link_attribute_name?: string;
+            description_extract_from?: ExtractFrom;
+            description_attribute_name?: string;
+            author_extract_from?: ExtractFrom;
+            author_attribute_name?: string;
+            enclosure_extract_from?: ExtractFrom;
+            enclosure_attribute_name?: string;
+            selector_updated?: string;
+            updated_extract_from?: ExtractFrom;
+            updated_attribute_name?: string;
+            selector_post_dialect?: SelectorDialect;
+            selector_title_dialect?: SelectorDialect;
+            selector_link_dialect?: SelectorDialect;
+            selector_description_dialect?: SelectorDialect;
+            selector_author_dialect?: SelectorDialect;
+            selector_created_dialect?: SelectorDialect;
+            selector_content_dialect?: SelectorDialect;
+            selector_enclosure_dialect?: SelectorDialect;
+            selector_id_dialect?: SelectorDialect;
+            selector_fulltext_dialect?: SelectorDialect;
+            selector_next_page_dialect?: SelectorDialect;
+            selector_load_more_dialect?: SelectorDialect;
+            selector_updated_dialect?: SelectorDialect;
+            render_mode?: RenderMode;
+            source_type?: SourceType;
+            json_method?: HttpMethod;
+            json_body?: string;
+            json_query_language?: JsonQueryLanguage;
+            json_items?: string;
+            json_title?: string;
+            json_link?: string;
+            json_description?: string;
+            json_content?: string;
+            json_author?: string;
+            json_created?: string;
+            json_enclosure?: string;
+            structured_data?: StructuredDataMode;
         }): Specs {
             const message = new Specs({});
             if (data.url != null) {
@@ -189,6 +1404,186 @@ export namespace rssalchemy {
             if (data.cache_lifetime != null) {
                 message.cache_lifetime = data.cache_lifetime;
             }
+            if (data.format != null) {
+                message.format = data.format;
+            }
+            if (data.archive_items != null) {
+                message.archive_items = data.archive_items;
+            }
+            if (data.archive_days != null) {
+                message.archive_days = data.archive_days;
+            }
+            if (data.id_strategy != null) {
+                message.id_strategy = data.id_strategy;
+            }
+            if (data.selector_id != null) {
+                message.selector_id = data.selector_id;
+            }
+            if (data.id_attribute_name != null) {
+                message.id_attribute_name = data.id_attribute_name;
+            }
+            if (data.id_fields != null) {
+                message.id_fields = data.id_fields;
+            }
+            if (data.feed_title != null) {
+                message.feed_title = data.feed_title;
+            }
+            if (data.feed_subtitle != null) {
+                message.feed_subtitle = data.feed_subtitle;
+            }
+            if (data.fulltext_mode != null) {
+                message.fulltext_mode = data.fulltext_mode;
+            }
+            if (data.selector_fulltext != null) {
+                message.selector_fulltext = data.selector_fulltext;
+            }
+            if (data.selector_next_page != null) {
+                message.selector_next_page = data.selector_next_page;
+            }
+            if (data.max_pages != null) {
+                message.max_pages = data.max_pages;
+            }
+            if (data.scroll_steps != null) {
+                message.scroll_steps = data.scroll_steps;
+            }
+            if (data.selector_load_more != null) {
+                message.selector_load_more = data.selector_load_more;
+            }
+            if (data.target_items != null) {
+                message.target_items = data.target_items;
+            }
+            if (data.actions != null) {
+                message.actions = data.actions.map(item => Action.fromObject(item));
+            }
+            if (data.filters != null) {
+                message.filters = data.filters.map(item => Filter.fromObject(item));
+            }
+            if (data.filter_mode != null) {
+                message.filter_mode = data.filter_mode;
+            }
+            if (data.transforms != null) {
+                message.transforms = data.transforms.map(item => Transform.fromObject(item));
+            }
+            if (data.title_extract_from != null) {
+                message.title_extract_from = data.title_extract_from;
+            }
+            if (data.title_attribute_name != null) {
+                message.title_attribute_name = data.title_attribute_name;
+            }
+            if (data.link_extract_from != null) {
+                message.link_extract_from = data.link_extract_from;
+            }
+            if (data.link_attribute_name != null) {
+                message.link_attribute_name = data.link_attribute_name;
+            }
+            if (data.description_extract_from != null) {
+                message.description_extract_from = data.description_extract_from;
+            }
+            if (data.description_attribute_name != null) {
+                message.description_attribute_name = data.description_attribute_name;
+            }
+            if (data.author_extract_from != null) {
+                message.author_extract_from = data.author_extract_from;
+            }
+            if (data.author_attribute_name != null) {
+                message.author_attribute_name = data.author_attribute_name;
+            }
+            if (data.enclosure_extract_from != null) {
+                message.enclosure_extract_from = data.enclosure_extract_from;
+            }
+            if (data.enclosure_attribute_name != null) {
+                message.enclosure_attribute_name = data.enclosure_attribute_name;
+            }
+            if (data.selector_updated != null) {
+                message.selector_updated = data.selector_updated;
+            }
+            if (data.updated_extract_from != null) {
+                message.updated_extract_from = data.updated_extract_from;
+            }
+            if (data.updated_attribute_name != null) {
+                message.updated_attribute_name = data.updated_attribute_name;
+            }
+            if (data.selector_post_dialect != null) {
+                message.selector_post_dialect = data.selector_post_dialect;
+            }
+            if (data.selector_title_dialect != null) {
+                message.selector_title_dialect = data.selector_title_dialect;
+            }
+            if (data.selector_link_dialect != null) {
+                message.selector_link_dialect = data.selector_link_dialect;
+            }
+            if (data.selector_description_dialect != null) {
+                message.selector_description_dialect = data.selector_description_dialect;
+            }
+            if (data.selector_author_dialect != null) {
+                message.selector_author_dialect = data.selector_author_dialect;
+            }
+            if (data.selector_created_dialect != null) {
+                message.selector_created_dialect = data.selector_created_dialect;
+            }
+            if (data.selector_content_dialect != null) {
+                message.selector_content_dialect = data.selector_content_dialect;
+            }
+            if (data.selector_enclosure_dialect != null) {
+                message.selector_enclosure_dialect = data.selector_enclosure_dialect;
+            }
+            if (data.selector_id_dialect != null) {
+                message.selector_id_dialect = data.selector_id_dialect;
+            }
+            if (data.selector_fulltext_dialect != null) {
+                message.selector_fulltext_dialect = data.selector_fulltext_dialect;
+            }
+            if (data.selector_next_page_dialect != null) {
+                message.selector_next_page_dialect = data.selector_next_page_dialect;
+            }
+            if (data.selector_load_more_dialect != null) {
+                message.selector_load_more_dialect = data.selector_load_more_dialect;
+            }
+            if (data.selector_updated_dialect != null) {
+                message.selector_updated_dialect = data.selector_updated_dialect;
+            }
+            if (data.render_mode != null) {
+                message.render_mode = data.render_mode;
+            }
+            if (data.source_type != null) {
+                message.source_type = data.source_type;
+            }
+            if (data.json_method != null) {
+                message.json_method = data.json_method;
+            }
+            if (data.json_body != null) {
+                message.json_body = data.json_body;
+            }
+            if (data.json_query_language != null) {
+                message.json_query_language = data.json_query_language;
+            }
+            if (data.json_items != null) {
+                message.json_items = data.json_items;
+            }
+            if (data.json_title != null) {
+                message.json_title = data.json_title;
+            }
+            if (data.json_link != null) {
+                message.json_link = data.json_link;
+            }
+            if (data.json_description != null) {
+                message.json_description = data.json_description;
+            }
+            if (data.json_content != null) {
+                message.json_content = data.json_content;
+            }
+            if (data.json_author != null) {
+                message.json_author = data.json_author;
+            }
+            if (data.json_created != null) {
+                message.json_created = data.json_created;
+            }
+            if (data.json_enclosure != null) {
+                message.json_enclosure = data.json_enclosure;
+            }
+            if (data.structured_data != null) {
+                message.structured_data = data.structured_data;
+            }
             return message;
         }
         toObject() {
@@ -205,6 +1600,66 @@ export namespace rssalchemy {
                 selector_content?: string;
                 selector_enclosure?: string;
                 cache_lifetime?: string;
+                format?: FeedFormat;
+                archive_items?: number;
+                archive_days?: number;
+                id_strategy?: IdStrategy;
+                selector_id?: string;
+                id_attribute_name?: string;
+                id_fields?: string[];
+                feed_title?: string;
+                feed_subtitle?: string;
+                fulltext_mode?: FullTextMode;
+                selector_fulltext?: string;
+                selector_next_page?: string;
+                max_pages?: number;
+                scroll_steps?: number;
+                selector_load_more?: string;
+                target_items?: number;
+                actions?: ReturnType<typeof Action.prototype.toObject>[];
+                filters?: ReturnType<typeof Filter.prototype.toObject>[];
+                filter_mode?: FilterMode;
+                transforms?: ReturnType<typeof Transform.prototype.toObject>[];
+                title_extract_from?: ExtractFrom;
+                title_attribute_name?: string;
+                link_extract_from?: ExtractFrom;
+                link_attribute_name?: string;
+                description_extract_from?: ExtractFrom;
+                description_attribute_name?: string;
+                author_extract_from?: ExtractFrom;
+                author_attribute_name?: string;
+                enclosure_extract_from?: ExtractFrom;
+                enclosure_attribute_name?: string;
+                selector_updated?: string;
+                updated_extract_from?: ExtractFrom;
+                updated_attribute_name?: string;
+                selector_post_dialect?: SelectorDialect;
+                selector_title_dialect?: SelectorDialect;
+                selector_link_dialect?: SelectorDialect;
+                selector_description_dialect?: SelectorDialect;
+                selector_author_dialect?: SelectorDialect;
+                selector_created_dialect?: SelectorDialect;
+                selector_content_dialect?: SelectorDialect;
+                selector_enclosure_dialect?: SelectorDialect;
+                selector_id_dialect?: SelectorDialect;
+                selector_fulltext_dialect?: SelectorDialect;
+                selector_next_page_dialect?: SelectorDialect;
+                selector_load_more_dialect?: SelectorDialect;
+                selector_updated_dialect?: SelectorDialect;
+                render_mode?: RenderMode;
+                source_type?: SourceType;
+                json_method?: HttpMethod;
+                json_body?: string;
+                json_query_language?: JsonQueryLanguage;
+                json_items?: string;
+                json_title?: string;
+                json_link?: string;
+                json_description?: string;
+                json_content?: string;
+                json_author?: string;
+                json_created?: string;
+                json_enclosure?: string;
+                structured_data?: StructuredDataMode;
             } = {};
             if (this.url != null) {
                 data.url = this.url;
@@ -242,6 +1697,186 @@ export namespace rssalchemy {
             if (this.cache_lifetime != null) {
                 data.cache_lifetime = this.cache_lifetime;
             }
+            if (this.format != null) {
+                data.format = this.format;
+            }
+            if (this.archive_items != null) {
+                data.archive_items = this.archive_items;
+            }
+            if (this.archive_days != null) {
+                data.archive_days = this.archive_days;
+            }
+            if (this.id_strategy != null) {
+                data.id_strategy = this.id_strategy;
+            }
+            if (this.selector_id != null) {
+                data.selector_id = this.selector_id;
+            }
+            if (this.id_attribute_name != null) {
+                data.id_attribute_name = this.id_attribute_name;
+            }
+            if (this.id_fields != null) {
+                data.id_fields = this.id_fields;
+            }
+            if (this.feed_title != null) {
+                data.feed_title = this.feed_title;
+            }
+            if (this.feed_subtitle != null) {
+                data.feed_subtitle = this.feed_subtitle;
+            }
+            if (this.fulltext_mode != null) {
+                data.fulltext_mode = this.fulltext_mode;
+            }
+            if (this.selector_fulltext != null) {
+                data.selector_fulltext = this.selector_fulltext;
+            }
+            if (this.selector_next_page != null) {
+                data.selector_next_page = this.selector_next_page;
+            }
+            if (this.max_pages != null) {
+                data.max_pages = this.max_pages;
+            }
+            if (this.scroll_steps != null) {
+                data.scroll_steps = this.scroll_steps;
+            }
+            if (this.selector_load_more != null) {
+                data.selector_load_more = this.selector_load_more;
+            }
+            if (this.target_items != null) {
+                data.target_items = this.target_items;
+            }
+            if (this.actions != null) {
+                data.actions = this.actions.map((item: Action) => item.toObject());
+            }
+            if (this.filters != null) {
+                data.filters = this.filters.map((item: Filter) => item.toObject());
+            }
+            if (this.filter_mode != null) {
+                data.filter_mode = this.filter_mode;
+            }
+            if (this.transforms != null) {
+                data.transforms = this.transforms.map((item: Transform) => item.toObject());
+            }
+            if (this.title_extract_from != null) {
+                data.title_extract_from = this.title_extract_from;
+            }
+            if (this.title_attribute_name != null) {
+                data.title_attribute_name = this.title_attribute_name;
+            }
+            if (this.link_extract_from != null) {
+                data.link_extract_from = this.link_extract_from;
+            }
+            if (this.link_attribute_name != null) {
+                data.link_attribute_name = this.link_attribute_name;
+            }
+            if (this.description_extract_from != null) {
+                data.description_extract_from = this.description_extract_from;
+            }
+            if (this.description_attribute_name != null) {
+                data.description_attribute_name = this.description_attribute_name;
+            }
+            if (this.author_extract_from != null) {
+                data.author_extract_from = this.author_extract_from;
+            }
+            if (this.author_attribute_name != null) {
+                data.author_attribute_name = this.author_attribute_name;
+            }
+            if (this.enclosure_extract_from != null) {
+                data.enclosure_extract_from = this.enclosure_extract_from;
+            }
+            if (this.enclosure_attribute_name != null) {
+                data.enclosure_attribute_name = this.enclosure_attribute_name;
+            }
+            if (this.selector_updated != null) {
+                data.selector_updated = this.selector_updated;
+            }
+            if (this.updated_extract_from != null) {
+                data.updated_extract_from = this.updated_extract_from;
+            }
+            if (this.updated_attribute_name != null) {
+                data.updated_attribute_name = this.updated_attribute_name;
+            }
+            if (this.selector_post_dialect != null) {
+                data.selector_post_dialect = this.selector_post_dialect;
+            }
+            if (this.selector_title_dialect != null) {
+                data.selector_title_dialect = this.selector_title_dialect;
+            }
+            if (this.selector_link_dialect != null) {
+                data.selector_link_dialect = this.selector_link_dialect;
+            }
+            if (this.selector_description_dialect != null) {
+                data.selector_description_dialect = this.selector_description_dialect;
+            }
+            if (this.selector_author_dialect != null) {
+                data.selector_author_dialect = this.selector_author_dialect;
+            }
+            if (this.selector_created_dialect != null) {
+                data.selector_created_dialect = this.selector_created_dialect;
+            }
+            if (this.selector_content_dialect != null) {
+                data.selector_content_dialect = this.selector_content_dialect;
+            }
+            if (this.selector_enclosure_dialect != null) {
+                data.selector_enclosure_dialect = this.selector_enclosure_dialect;
+            }
+            if (this.selector_id_dialect != null) {
+                data.selector_id_dialect = this.selector_id_dialect;
+            }
+            if (this.selector_fulltext_dialect != null) {
+                data.selector_fulltext_dialect = this.selector_fulltext_dialect;
+            }
+            if (this.selector_next_page_dialect != null) {
+                data.selector_next_page_dialect = this.selector_next_page_dialect;
+            }
+            if (this.selector_load_more_dialect != null) {
+                data.selector_load_more_dialect = this.selector_load_more_dialect;
+            }
+            if (this.selector_updated_dialect != null) {
+                data.selector_updated_dialect = this.selector_updated_dialect;
+            }
+            if (this.render_mode != null) {
+                data.render_mode = this.render_mode;
+            }
+            if (this.source_type != null) {
+                data.source_type = this.source_type;
+            }
+            if (this.json_method != null) {
+                data.json_method = this.json_method;
+            }
+            if (this.json_body != null) {
+                data.json_body = this.json_body;
+            }
+            if (this.json_query_language != null) {
+                data.json_query_language = this.json_query_language;
+            }
+            if (this.json_items != null) {
+                data.json_items = this.json_items;
+            }
+            if (this.json_title != null) {
+                data.json_title = this.json_title;
+            }
+            if (this.json_link != null) {
+                data.json_link = this.json_link;
+            }
+            if (this.json_description != null) {
+                data.json_description = this.json_description;
+            }
+            if (this.json_content != null) {
+                data.json_content = this.json_content;
+            }
+            if (this.json_author != null) {
+                data.json_author = this.json_author;
+            }
+            if (this.json_created != null) {
+                data.json_created = this.json_created;
+            }
+            if (this.json_enclosure != null) {
+                data.json_enclosure = this.json_enclosure;
+            }
+            if (this.structured_data != null) {
+                data.structured_data = this.structured_data;
+            }
             return data;
         }
         serialize(): Uint8Array;
@@ -272,6 +1907,126 @@ export namespace rssalchemy {
                 writer.writeString(9, this.selector_enclosure);
             if (this.cache_lifetime.length)
                 writer.writeString(10, this.cache_lifetime);
+            if (this.format != FeedFormat.Atom)
+                writer.writeEnum(13, this.format);
+            if (this.archive_items != 0)
+                writer.writeInt32(14, this.archive_items);
+            if (this.archive_days != 0)
+                writer.writeInt32(15, this.archive_days);
+            if (this.id_strategy != IdStrategy.LinkDate)
+                writer.writeEnum(16, this.id_strategy);
+            if (this.selector_id.length)
+                writer.writeString(17, this.selector_id);
+            if (this.id_attribute_name.length)
+                writer.writeString(18, this.id_attribute_name);
+            if (this.id_fields.length)
+                writer.writeRepeatedString(19, this.id_fields);
+            if (this.feed_title.length)
+                writer.writeString(20, this.feed_title);
+            if (this.feed_subtitle.length)
+                writer.writeString(21, this.feed_subtitle);
+            if (this.fulltext_mode != FullTextMode.Off)
+                writer.writeEnum(22, this.fulltext_mode);
+            if (this.selector_fulltext.length)
+                writer.writeString(23, this.selector_fulltext);
+            if (this.selector_next_page.length)
+                writer.writeString(24, this.selector_next_page);
+            if (this.max_pages != 0)
+                writer.writeInt32(25, this.max_pages);
+            if (this.scroll_steps != 0)
+                writer.writeInt32(26, this.scroll_steps);
+            if (this.selector_load_more.length)
+                writer.writeString(27, this.selector_load_more);
+            if (this.target_items != 0)
+                writer.writeInt32(28, this.target_items);
+            if (this.actions.length)
+                writer.writeRepeatedMessage(29, this.actions, (item: Action) => item.serialize(writer));
+            if (this.filters.length)
+                writer.writeRepeatedMessage(30, this.filters, (item: Filter) => item.serialize(writer));
+            if (this.filter_mode != FilterMode.All)
+                writer.writeEnum(31, this.filter_mode);
+            if (this.transforms.length)
+                writer.writeRepeatedMessage(32, this.transforms, (item: Transform) => item.serialize(writer));
+            if (this.title_extract_from != ExtractFrom.InnerText)
+                writer.writeEnum(33, this.title_extract_from);
+            if (this.title_attribute_name.length)
+                writer.writeString(34, this.title_attribute_name);
+            if (this.link_extract_from != ExtractFrom.InnerText)
+                writer.writeEnum(35, this.link_extract_from);
+            if (this.link_attribute_name.length)
+                writer.writeString(36, this.link_attribute_name);
+            if (this.description_extract_from != ExtractFrom.InnerText)
+                writer.writeEnum(37, this.description_extract_from);
+            if (this.description_attribute_name.length)
+                writer.writeString(38, this.description_attribute_name);
+            if (this.author_extract_from != ExtractFrom.InnerText)
+                writer.writeEnum(39, this.author_extract_from);
+            if (this.author_attribute_name.length)
+                writer.writeString(40, this.author_attribute_name);
+            if (this.enclosure_extract_from != ExtractFrom.InnerText)
+                writer.writeEnum(41, this.enclosure_extract_from);
+            if (this.enclosure_attribute_name.length)
+                writer.writeString(42, this.enclosure_attribute_name);
+            if (this.selector_updated.length)
+                writer.writeString(43, this.selector_updated);
+            if (this.updated_extract_from != ExtractFrom.InnerText)
+                writer.writeEnum(44, this.updated_extract_from);
+            if (this.updated_attribute_name.length)
+                writer.writeString(45, this.updated_attribute_name);
+            if (this.selector_post_dialect != SelectorDialect.Css)
+                writer.writeEnum(46, this.selector_post_dialect);
+            if (this.selector_title_dialect != SelectorDialect.Css)
+                writer.writeEnum(47, this.selector_title_dialect);
+            if (this.selector_link_dialect != SelectorDialect.Css)
+                writer.writeEnum(48, this.selector_link_dialect);
+            if (this.selector_description_dialect != SelectorDialect.Css)
+                writer.writeEnum(49, this.selector_description_dialect);
+            if (this.selector_author_dialect != SelectorDialect.Css)
+                writer.writeEnum(50, this.selector_author_dialect);
+            if (this.selector_created_dialect != SelectorDialect.Css)
+                writer.writeEnum(51, this.selector_created_dialect);
+            if (this.selector_content_dialect != SelectorDialect.Css)
+                writer.writeEnum(52, this.selector_content_dialect);
+            if (this.selector_enclosure_dialect != SelectorDialect.Css)
+                writer.writeEnum(53, this.selector_enclosure_dialect);
+            if (this.selector_id_dialect != SelectorDialect.Css)
+                writer.writeEnum(54, this.selector_id_dialect);
+            if (this.selector_fulltext_dialect != SelectorDialect.Css)
+                writer.writeEnum(55, this.selector_fulltext_dialect);
+            if (this.selector_next_page_dialect != SelectorDialect.Css)
+                writer.writeEnum(56, this.selector_next_page_dialect);
+            if (this.selector_load_more_dialect != SelectorDialect.Css)
+                writer.writeEnum(57, this.selector_load_more_dialect);
+            if (this.selector_updated_dialect != SelectorDialect.Css)
+                writer.writeEnum(58, this.selector_updated_dialect);
+            if (this.render_mode != RenderMode.Browser)
+                writer.writeEnum(59, this.render_mode);
+            if (this.source_type != SourceType.Page)
+                writer.writeEnum(60, this.source_type);
+            if (this.json_method != HttpMethod.Get)
+                writer.writeEnum(61, this.json_method);
+            if (this.json_body.length)
+                writer.writeString(62, this.json_body);
+            if (this.json_query_language != JsonQueryLanguage.JsonPath)
+                writer.writeEnum(63, this.json_query_language);
+            if (this.json_items.length)
+                writer.writeString(64, this.json_items);
+            if (this.json_title.length)
+                writer.writeString(65, this.json_title);
+            if (this.json_link.length)
+                writer.writeString(66, this.json_link);
+            if (this.json_description.length)
+                writer.writeString(67, this.json_description);
+            if (this.json_content.length)
+                writer.writeString(68, this.json_content);
+            if (this.json_author.length)
+                writer.writeString(69, this.json_author);
+            if (this.json_created.length)
+                writer.writeString(70, this.json_created);
+            if (this.json_enclosure.length)
+                writer.writeString(71, this.json_enclosure);
+            if (this.structured_data != StructuredDataMode.StructuredDataOff)
+                writer.writeEnum(72, this.structured_data);
             if (!w)
                 return writer.getResultBuffer();
         }
@@ -317,6 +2072,186 @@ export namespace rssalchemy {
                     case 10:
                         message.cache_lifetime = reader.readString();
                         break;
+                    case 13:
+                        message.format = reader.readEnum();
+                        break;
+                    case 14:
+                        message.archive_items = reader.readInt32();
+                        break;
+                    case 15:
+                        message.archive_days = reader.readInt32();
+                        break;
+                    case 16:
+                        message.id_strategy = reader.readEnum();
+                        break;
+                    case 17:
+                        message.selector_id = reader.readString();
+                        break;
+                    case 18:
+                        message.id_attribute_name = reader.readString();
+                        break;
+                    case 19:
+                        pb_1.Message.addToRepeatedField(message, 19, reader.readString());
+                        break;
+                    case 20:
+                        message.feed_title = reader.readString();
+                        break;
+                    case 21:
+                        message.feed_subtitle = reader.readString();
+                        break;
+                    case 22:
+                        message.fulltext_mode = reader.readEnum();
+                        break;
+                    case 23:
+                        message.selector_fulltext = reader.readString();
+                        break;
+                    case 24:
+                        message.selector_next_page = reader.readString();
+                        break;
+                    case 25:
+                        message.max_pages = reader.readInt32();
+                        break;
+                    case 26:
+                        message.scroll_steps = reader.readInt32();
+                        break;
+                    case 27:
+                        message.selector_load_more = reader.readString();
+                        break;
+                    case 28:
+                        message.target_items = reader.readInt32();
+                        break;
+                    case 29:
+                        reader.readMessage(message.actions, () => pb_1.Message.addToRepeatedWrapperField(message, 29, Action.deserialize(reader), Action));
+                        break;
+                    case 30:
+                        reader.readMessage(message.filters, () => pb_1.Message.addToRepeatedWrapperField(message, 30, Filter.deserialize(reader), Filter));
+                        break;
+                    case 31:
+                        message.filter_mode = reader.readEnum();
+                        break;
+                    case 32:
+                        reader.readMessage(message.transforms, () => pb_1.Message.addToRepeatedWrapperField(message, 32, Transform.deserialize(reader), Transform));
+                        break;
+                    case 33:
+                        message.title_extract_from = reader.readEnum();
+                        break;
+                    case 34:
+                        message.title_attribute_name = reader.readString();
+                        break;
+                    case 35:
+                        message.link_extract_from = reader.readEnum();
+                        break;
+                    case 36:
+                        message.link_attribute_name = reader.readString();
+                        break;
+                    case 37:
+                        message.description_extract_from = reader.readEnum();
+                        break;
+                    case 38:
+                        message.description_attribute_name = reader.readString();
+                        break;
+                    case 39:
+                        message.author_extract_from = reader.readEnum();
+                        break;
+                    case 40:
+                        message.author_attribute_name = reader.readString();
+                        break;
+                    case 41:
+                        message.enclosure_extract_from = reader.readEnum();
+                        break;
+                    case 42:
+                        message.enclosure_attribute_name = reader.readString();
+                        break;
+                    case 43:
+                        message.selector_updated = reader.readString();
+                        break;
+                    case 44:
+                        message.updated_extract_from = reader.readEnum();
+                        break;
+                    case 45:
+                        message.updated_attribute_name = reader.readString();
+                        break;
+                    case 46:
+                        message.selector_post_dialect = reader.readEnum();
+                        break;
+                    case 47:
+                        message.selector_title_dialect = reader.readEnum();
+                        break;
+                    case 48:
+                        message.selector_link_dialect = reader.readEnum();
+                        break;
+                    case 49:
+                        message.selector_description_dialect = reader.readEnum();
+                        break;
+                    case 50:
+                        message.selector_author_dialect = reader.readEnum();
+                        break;
+                    case 51:
+                        message.selector_created_dialect = reader.readEnum();
+                        break;
+                    case 52:
+                        message.selector_content_dialect = reader.readEnum();
+                        break;
+                    case 53:
+                        message.selector_enclosure_dialect = reader.readEnum();
+                        break;
+                    case 54:
+                        message.selector_id_dialect = reader.readEnum();
+                        break;
+                    case 55:
+                        message.selector_fulltext_dialect = reader.readEnum();
+                        break;
+                    case 56:
+                        message.selector_next_page_dialect = reader.readEnum();
+                        break;
+                    case 57:
+                        message.selector_load_more_dialect = reader.readEnum();
+                        break;
+                    case 58:
+                        message.selector_updated_dialect = reader.readEnum();
+                        break;
+                    case 59:
+                        message.render_mode = reader.readEnum();
+                        break;
+                    case 60:
+                        message.source_type = reader.readEnum();
+                        break;
+                    case 61:
+                        message.json_method = reader.readEnum();
+                        break;
+                    case 62:
+                        message.json_body = reader.readString();
+                        break;
+                    case 63:
+                        message.json_query_language = reader.readEnum();
+                        break;
+                    case 64:
+                        message.json_items = reader.readString();
+                        break;
+                    case 65:
+                        message.json_title = reader.readString();
+                        break;
+                    case 66:
+                        message.json_link = reader.readString();
+                        break;
+                    case 67:
+                        message.json_description = reader.readString();
+                        break;
+                    case 68:
+                        message.json_content = reader.readString();
+                        break;
+                    case 69:
+                        message.json_author = reader.readString();
+                        break;
+                    case 70:
+                        message.json_created = reader.readString();
+                        break;
+                    case 71:
+                        message.json_enclosure = reader.readString();
+                        break;
+                    case 72:
+                        message.structured_data = reader.readEnum();
+                        break;
                     default: reader.skipField();
                 }
             }
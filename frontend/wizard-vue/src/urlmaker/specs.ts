@@ -1,7 +1,15 @@
 import {
+  idFields,
+  validateActions,
   validateAttribute,
   validateDuration,
+  validateFilters,
+  validateIdFields,
+  validateJsonBody,
+  validateJsonQuery,
+  validateNonNegativeInt,
   validateSelector,
+  validateTransforms,
   validateUrl,
   type validator
 } from "@/urlmaker/validators.ts";
@@ -10,26 +18,95 @@ import type {Enum} from "@/common/enum.ts";
 
 export const defaultSpecs = {
   url: '',
+  source_type: rssalchemy.SourceType.Page,
+  json_method: rssalchemy.HttpMethod.Get,
+  json_body: '',
+  json_query_language: rssalchemy.JsonQueryLanguage.JsonPath,
+  json_items: '',
+  json_title: '',
+  json_link: '',
+  json_description: '',
+  json_content: '',
+  json_author: '',
+  json_created: '',
+  json_enclosure: '',
+  render_mode: rssalchemy.RenderMode.Browser,
+  structured_data: rssalchemy.StructuredDataMode.StructuredDataOff,
   selector_post: '',
   selector_title: '',
+  title_extract_from: rssalchemy.ExtractFrom.InnerText,
+  title_attribute_name: '',
   selector_link: '',
+  link_extract_from: rssalchemy.ExtractFrom.InnerText,
+  link_attribute_name: '',
   selector_description: '',
+  description_extract_from: rssalchemy.ExtractFrom.InnerText,
+  description_attribute_name: '',
   selector_author: '',
+  author_extract_from: rssalchemy.ExtractFrom.InnerText,
+  author_attribute_name: '',
   selector_content: '',
   selector_enclosure: '',
+  enclosure_extract_from: rssalchemy.ExtractFrom.InnerText,
+  enclosure_attribute_name: '',
   selector_created: '',
   created_extract_from: rssalchemy.ExtractFrom.InnerText,
   created_attribute_name: '',
-  cache_lifetime: '10m'
+  selector_updated: '',
+  updated_extract_from: rssalchemy.ExtractFrom.InnerText,
+  updated_attribute_name: '',
+  cache_lifetime: '10m',
+  format: rssalchemy.FeedFormat.Atom,
+  archive_items: 0,
+  archive_days: 0,
+  id_strategy: rssalchemy.IdStrategy.LinkDate,
+  selector_id: '',
+  id_attribute_name: '',
+  id_fields: [] as string[],
+  feed_title: '',
+  feed_subtitle: '',
+  fulltext_mode: rssalchemy.FullTextMode.Off,
+  selector_fulltext: '',
+  selector_next_page: '',
+  max_pages: 0,
+  scroll_steps: 0,
+  selector_load_more: '',
+  target_items: 0,
+  actions: [] as Action[],
+  filters: [] as Filter[],
+  filter_mode: rssalchemy.FilterMode.All,
+  transforms: [] as Transform[],
+  selector_post_dialect: rssalchemy.SelectorDialect.Css,
+  selector_title_dialect: rssalchemy.SelectorDialect.Css,
+  selector_link_dialect: rssalchemy.SelectorDialect.Css,
+  selector_description_dialect: rssalchemy.SelectorDialect.Css,
+  selector_author_dialect: rssalchemy.SelectorDialect.Css,
+  selector_created_dialect: rssalchemy.SelectorDialect.Css,
+  selector_content_dialect: rssalchemy.SelectorDialect.Css,
+  selector_enclosure_dialect: rssalchemy.SelectorDialect.Css,
+  selector_id_dialect: rssalchemy.SelectorDialect.Css,
+  selector_fulltext_dialect: rssalchemy.SelectorDialect.Css,
+  selector_next_page_dialect: rssalchemy.SelectorDialect.Css,
+  selector_load_more_dialect: rssalchemy.SelectorDialect.Css,
+  selector_updated_dialect: rssalchemy.SelectorDialect.Css,
 };
 
-export type SpecValue = string | number;
+export type Action = ReturnType<rssalchemy.Action['toObject']>;
+export type Filter = ReturnType<rssalchemy.Filter['toObject']>;
+export type Transform = ReturnType<rssalchemy.Transform['toObject']>;
+export type SpecValue = string | number | string[] | Action[] | Filter[] | Transform[];
 export type Specs = typeof defaultSpecs;
 
 export enum InputType {
   Url = 'url',
   Text = 'text',
-  Radio = 'radio'
+  Number = 'number',
+  Radio = 'radio',
+  Checkboxes = 'checkboxes',
+  Actions = 'actions',
+  Filters = 'filters',
+  Transforms = 'transforms',
+  Selector = 'selector'
 }
 
 export interface SpecField {
@@ -41,6 +118,74 @@ export interface SpecField {
   required?: boolean
   group?: string
   show_if?: (specs: Specs) => boolean
+  dialect?: keyof Specs
+}
+
+const isPage = (specs: Specs) => specs.source_type === rssalchemy.SourceType.Page;
+const isJson = (specs: Specs) => specs.source_type === rssalchemy.SourceType.JsonApi;
+// usesSelectors is false when posts are taken only from structured data
+const usesSelectors = (specs: Specs) => (
+  isPage(specs) && specs.structured_data !== rssalchemy.StructuredDataMode.StructuredDataOnly
+);
+
+// selectorInput is for selector fields, which have dialect chosen in dialect field
+function selectorInput(dialect: keyof Specs): Pick<SpecField, 'input_type' | 'dialect' | 'validate'> {
+  return {
+    input_type: InputType.Selector,
+    dialect: dialect,
+    validate: (value, specs) => validateSelector(value, specs[dialect] as rssalchemy.SelectorDialect),
+  };
+}
+
+type ExtractableField = 'title' | 'link' | 'description' | 'author' | 'created' | 'updated' | 'enclosure';
+
+// extractFromFields returns extraction mode and attribute name fields for field with selector.
+// Link and enclosure are read from attribute by default, InnerText value stands for it
+function extractFromFields(field: ExtractableField, defaultAttribute?: string): SpecField[] {
+  const selector = `selector_${field}` as keyof Specs;
+  const extractFrom = `${field}_extract_from` as keyof Specs;
+  return [
+    {
+      name: extractFrom,
+      input_type: InputType.Radio,
+      enum: [
+        defaultAttribute
+          ? {label: `Attribute ${defaultAttribute}`, value: rssalchemy.ExtractFrom.InnerText}
+          : {label: 'Inner Text', value: rssalchemy.ExtractFrom.InnerText},
+        {label: defaultAttribute ? 'Other attribute' : 'Attribute', value: rssalchemy.ExtractFrom.Attribute},
+        {label: 'Inner HTML', value: rssalchemy.ExtractFrom.InnerHtml},
+        {label: 'Text content', value: rssalchemy.ExtractFrom.TextContent},
+      ],
+      label: 'Extract from',
+      validate: value => Object.values(rssalchemy.ExtractFrom).includes(value as number),
+      group: field,
+      show_if: specs => usesSelectors(specs) && !!specs[selector],
+    },
+    {
+      name: `${field}_attribute_name` as keyof Specs,
+      input_type: InputType.Text,
+      label: 'Attribute name',
+      validate: validateAttribute,
+      show_if: specs => usesSelectors(specs) && !!specs[selector] && specs[extractFrom] === rssalchemy.ExtractFrom.Attribute,
+      group: field,
+    },
+  ];
+}
+
+type JsonQueryField = 'items' | 'title' | 'link' | 'description' | 'content' | 'author' | 'created' | 'enclosure';
+
+// jsonQueryField is a field of json api source, required ones must be set only for this source
+function jsonQueryField(field: JsonQueryField, label: string, required = false): SpecField {
+  return {
+    name: `json_${field}` as keyof Specs,
+    input_type: InputType.Text,
+    label: label,
+    validate: (value, specs) => (
+      !isJson(specs) || (!value && !required) || validateJsonQuery(value, specs.json_query_language)
+    ),
+    show_if: isJson,
+    group: 'json',
+  };
 }
 
 export const fields: SpecField[] = [
@@ -52,76 +197,248 @@ export const fields: SpecField[] = [
     required: true,
   },
   {
-    name: 'selector_post',
+    name: 'source_type',
+    input_type: InputType.Radio,
+    enum: [
+      {label: 'Web page', value: rssalchemy.SourceType.Page},
+      {label: 'JSON API', value: rssalchemy.SourceType.JsonApi},
+    ],
+    label: 'Source',
+    validate: value => Object.values(rssalchemy.SourceType).includes(value as number),
+  },
+  {
+    name: 'json_method',
+    input_type: InputType.Radio,
+    enum: [
+      {label: 'GET', value: rssalchemy.HttpMethod.Get},
+      {label: 'POST', value: rssalchemy.HttpMethod.Post},
+    ],
+    label: 'Request method',
+    validate: value => Object.values(rssalchemy.HttpMethod).includes(value as number),
+    show_if: isJson,
+    group: 'json',
+  },
+  {
+    name: 'json_body',
     input_type: InputType.Text,
-    label: 'CSS Selector for post',
-    validate: validateSelector,
+    label: 'Request body (JSON)',
+    validate: validateJsonBody,
+    show_if: specs => isJson(specs) && specs.json_method === rssalchemy.HttpMethod.Post,
+    group: 'json',
+  },
+  {
+    name: 'json_query_language',
+    input_type: InputType.Radio,
+    enum: [
+      {label: 'JSONPath', value: rssalchemy.JsonQueryLanguage.JsonPath},
+      {label: 'JMESPath', value: rssalchemy.JsonQueryLanguage.JmesPath},
+    ],
+    label: 'Query language',
+    validate: value => Object.values(rssalchemy.JsonQueryLanguage).includes(value as number),
+    show_if: isJson,
+    group: 'json',
+  },
+  jsonQueryField('items', 'Query for items array (e.g. $.data.posts)', true),
+  jsonQueryField('title', 'Query for title, relative to item (e.g. $.title)', true),
+  jsonQueryField('link', 'Query for link', true),
+  jsonQueryField('description', 'Query for description'),
+  jsonQueryField('content', 'Query for content'),
+  jsonQueryField('author', 'Query for author'),
+  jsonQueryField('created', 'Query for created date (string or unix timestamp)'),
+  jsonQueryField('enclosure', 'Query for enclosure'),
+  {
+    name: 'render_mode',
+    input_type: InputType.Radio,
+    enum: [
+      {label: 'Browser', value: rssalchemy.RenderMode.Browser},
+      {label: 'Static HTML (fast, no javascript, css selectors only)', value: rssalchemy.RenderMode.Static},
+      {label: 'Auto', value: rssalchemy.RenderMode.StaticOrBrowser},
+    ],
+    label: 'Page loading',
+    validate: value => Object.values(rssalchemy.RenderMode).includes(value as number),
+    show_if: isPage,
+  },
+  {
+    name: 'structured_data',
+    input_type: InputType.Radio,
+    enum: [
+      {label: 'Off', value: rssalchemy.StructuredDataMode.StructuredDataOff},
+      {label: 'When selectors find nothing', value: rssalchemy.StructuredDataMode.StructuredDataFallback},
+      {label: 'Only (no selectors needed)', value: rssalchemy.StructuredDataMode.StructuredDataOnly},
+    ],
+    label: 'Structured data (JSON-LD, microdata, OpenGraph)',
+    validate: value => Object.values(rssalchemy.StructuredDataMode).includes(value as number),
+    show_if: isPage,
+  },
+  {
+    name: 'actions',
+    input_type: InputType.Actions,
+    enum: [
+      {label: 'Click', value: rssalchemy.ActionType.Click},
+      {label: 'Fill', value: rssalchemy.ActionType.Fill},
+      {label: 'Press key', value: rssalchemy.ActionType.Press},
+      {label: 'Wait for selector', value: rssalchemy.ActionType.WaitForSelector},
+      {label: 'Wait for timeout', value: rssalchemy.ActionType.WaitForTimeout},
+      {label: 'Select option', value: rssalchemy.ActionType.SelectOption},
+    ],
+    label: 'Actions before extraction (e.g. close cookie banner)',
+    validate: validateActions,
+    show_if: specs => isPage(specs) && specs.render_mode !== rssalchemy.RenderMode.Static,
+  },
+  {
+    name: 'selector_post',
+    ...selectorInput('selector_post_dialect'),
+    label: 'Selector for post',
+    show_if: usesSelectors,
   },
   {
     name: 'selector_title',
-    input_type: InputType.Text,
-    label: 'CSS Selector for title',
-    validate: validateSelector,
+    ...selectorInput('selector_title_dialect'),
+    label: 'Selector for title',
+    show_if: usesSelectors,
+    group: 'title',
   },
+  ...extractFromFields('title'),
   {
     name: 'selector_link',
-    input_type: InputType.Text,
-    label: 'CSS Selector for link',
-    validate: validateSelector,
+    ...selectorInput('selector_link_dialect'),
+    label: 'Selector for link',
+    show_if: usesSelectors,
+    group: 'link',
   },
+  ...extractFromFields('link', 'href'),
   {
     name: 'selector_description',
-    input_type: InputType.Text,
-    label: 'CSS Selector for description',
-    validate: validateSelector,
+    ...selectorInput('selector_description_dialect'),
+    label: 'Selector for description',
+    show_if: usesSelectors,
+    group: 'description',
   },
+  ...extractFromFields('description'),
   {
     name: 'selector_author',
-    input_type: InputType.Text,
-    label: 'CSS Selector for author',
-    validate: validateSelector,
+    ...selectorInput('selector_author_dialect'),
+    label: 'Selector for author',
+    show_if: usesSelectors,
+    group: 'author',
   },
+  ...extractFromFields('author'),
 
   {
     name: 'selector_created',
-    input_type: InputType.Text,
-    label: 'CSS Selector for created date',
-    validate: validateSelector,
+    ...selectorInput('selector_created_dialect'),
+    label: 'Selector for created date (if empty, time when post was first seen is used)',
+    show_if: usesSelectors,
     group: 'created',
   },
+  ...extractFromFields('created'),
   {
-    name: 'created_extract_from',
+    name: 'selector_updated',
+    ...selectorInput('selector_updated_dialect'),
+    label: 'Selector for updated date (if empty, created date is used)',
+    show_if: usesSelectors,
+    group: 'updated',
+  },
+  ...extractFromFields('updated'),
+
+  {
+    name: 'selector_content',
+    ...selectorInput('selector_content_dialect'),
+    label: 'Selector for content',
+    show_if: usesSelectors,
+  },
+  {
+    name: 'fulltext_mode',
     input_type: InputType.Radio,
     enum: [
-      {label: 'Inner Text', value: rssalchemy.ExtractFrom.InnerText},
-      {label: 'Attribute', value: rssalchemy.ExtractFrom.Attribute},
+      {label: 'Off', value: rssalchemy.FullTextMode.Off},
+      {label: 'By selector', value: rssalchemy.FullTextMode.BySelector},
+      {label: 'Auto', value: rssalchemy.FullTextMode.Auto},
     ],
-    label: 'Extract from',
-    validate: value => Object.values(rssalchemy.ExtractFrom).includes(value),
-    group: 'created',
-    show_if: specs => !!specs.selector_created,
+    label: 'Full text from post page',
+    validate: value => Object.values(rssalchemy.FullTextMode).includes(value as number),
+    group: 'fulltext',
   },
   {
-    name: 'created_attribute_name',
-    input_type: InputType.Text,
-    label: 'Attribute name',
-    validate: validateAttribute,
-    show_if: specs =>
-      !!specs.selector_created && specs.created_extract_from === rssalchemy.ExtractFrom.Attribute,
-    group: 'created',
+    name: 'selector_fulltext',
+    ...selectorInput('selector_fulltext_dialect'),
+    label: 'Selector for full text on post page',
+    show_if: specs => specs.fulltext_mode === rssalchemy.FullTextMode.BySelector,
+    group: 'fulltext',
   },
-
   {
-    name: 'selector_content',
-    input_type: InputType.Text,
-    label: 'CSS Selector for content',
-    validate: validateSelector,
+    name: 'selector_enclosure',
+    ...selectorInput('selector_enclosure_dialect'),
+    label: 'Selector for enclosure (e.g. image url)',
+    show_if: usesSelectors,
+    group: 'enclosure',
   },
+  ...extractFromFields('enclosure', 'src'),
   {
-    name: 'selector_enclosure',
-    input_type: InputType.Text,
-    label: 'CSS Selector for enclosure (e.g. image url)',
-    validate: validateSelector,
+    name: 'scroll_steps',
+    input_type: InputType.Number,
+    label: 'Scroll steps for infinite scroll and "load more" button (up to 20)',
+    // "load more" button is clicked only on scroll steps
+    validate: (value, specs) => validateNonNegativeInt(value) && (value as number) <= 20
+      && (value as number > 0 || !specs.selector_load_more),
+    group: 'loadmore',
+    show_if: specs => usesSelectors(specs) && specs.render_mode !== rssalchemy.RenderMode.Static,
+  },
+  {
+    name: 'selector_load_more',
+    ...selectorInput('selector_load_more_dialect'),
+    label: 'Selector for "load more" button (clicked on each step)',
+    show_if: specs => usesSelectors(specs) && specs.render_mode !== rssalchemy.RenderMode.Static && specs.scroll_steps > 0,
+    group: 'loadmore',
+  },
+  {
+    name: 'target_items',
+    input_type: InputType.Number,
+    label: 'Stop loading more posts when this count is reached (0 - no limit)',
+    validate: value => validateNonNegativeInt(value) && (value as number) <= 500,
+    show_if: isPage,
+    group: 'loadmore',
+  },
+  {
+    name: 'selector_next_page',
+    ...selectorInput('selector_next_page_dialect'),
+    label: 'Selector for next page link or button',
+    show_if: isPage,
+    group: 'pages',
+  },
+  {
+    name: 'max_pages',
+    input_type: InputType.Number,
+    label: 'Max pages to visit (up to 10)',
+    validate: value => validateNonNegativeInt(value) && (value as number) <= 10,
+    show_if: specs => isPage(specs) && !!specs.selector_next_page,
+    group: 'pages',
+  },
+  {
+    name: 'filters',
+    input_type: InputType.Filters,
+    label: 'Filters',
+    validate: validateFilters,
+    group: 'filters',
+  },
+  {
+    name: 'filter_mode',
+    input_type: InputType.Radio,
+    enum: [
+      {label: 'All', value: rssalchemy.FilterMode.All},
+      {label: 'Any', value: rssalchemy.FilterMode.Any},
+    ],
+    label: 'Item must match include filters',
+    validate: value => Object.values(rssalchemy.FilterMode).includes(value as number),
+    show_if: specs => specs.filters.filter(f => !f.exclude).length > 1,
+    group: 'filters',
+  },
+  {
+    name: 'transforms',
+    input_type: InputType.Transforms,
+    label: 'Field transforms (applied in order; templates run after all fields are extracted)',
+    validate: validateTransforms,
+    group: 'transforms',
   },
   {
     name: 'cache_lifetime',
@@ -129,4 +446,80 @@ export const fields: SpecField[] = [
     label: 'Cache lifetime (format examples: 10s, 1m, 2h)',
     validate: validateDuration,
   },
+  {
+    name: 'feed_title',
+    input_type: InputType.Text,
+    label: 'Feed title (if empty, page title is used)',
+    validate: () => true,
+    group: 'feed',
+  },
+  {
+    name: 'feed_subtitle',
+    input_type: InputType.Text,
+    label: 'Feed subtitle (if empty, page description is used)',
+    validate: () => true,
+    group: 'feed',
+  },
+  {
+    name: 'format',
+    input_type: InputType.Radio,
+    enum: [
+      {label: 'Atom', value: rssalchemy.FeedFormat.Atom},
+      {label: 'RSS 2.0', value: rssalchemy.FeedFormat.Rss},
+      {label: 'JSON Feed', value: rssalchemy.FeedFormat.JsonFeed},
+    ],
+    label: 'Feed format',
+    validate: value => Object.values(rssalchemy.FeedFormat).includes(value as number),
+  },
+  {
+    name: 'archive_items',
+    input_type: InputType.Number,
+    label: 'Archive: max items, including ones gone from page (both 0 - archive disabled)',
+    validate: validateNonNegativeInt,
+    group: 'archive',
+  },
+  {
+    name: 'archive_days',
+    input_type: InputType.Number,
+    label: 'Archive: keep items for N days (0 - unlimited)',
+    validate: validateNonNegativeInt,
+    group: 'archive',
+  },
+  {
+    name: 'id_strategy',
+    input_type: InputType.Radio,
+    enum: [
+      {label: 'Link and date', value: rssalchemy.IdStrategy.LinkDate},
+      {label: 'Link', value: rssalchemy.IdStrategy.LinkHash},
+      {label: 'Selector', value: rssalchemy.IdStrategy.Selector},
+      {label: 'Fields', value: rssalchemy.IdStrategy.FieldsHash},
+    ],
+    label: 'Item ID from',
+    validate: value => Object.values(rssalchemy.IdStrategy).includes(value as number),
+    group: 'id',
+  },
+  {
+    name: 'selector_id',
+    ...selectorInput('selector_id_dialect'),
+    label: 'Selector for item ID',
+    show_if: specs => specs.id_strategy === rssalchemy.IdStrategy.Selector,
+    group: 'id',
+  },
+  {
+    name: 'id_attribute_name',
+    input_type: InputType.Text,
+    label: 'Item ID attribute name (if empty, inner text is used)',
+    validate: validateAttribute,
+    show_if: specs => specs.id_strategy === rssalchemy.IdStrategy.Selector,
+    group: 'id',
+  },
+  {
+    name: 'id_fields',
+    input_type: InputType.Checkboxes,
+    enum: idFields.map(field => ({label: field, value: field})),
+    label: 'Fields for item ID',
+    validate: validateIdFields,
+    show_if: specs => specs.id_strategy === rssalchemy.IdStrategy.FieldsHash,
+    group: 'id',
+  },
 ];
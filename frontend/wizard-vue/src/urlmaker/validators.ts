@@ -1,7 +1,8 @@
 import {presetPrefix} from "@/urlmaker/index.ts";
-import type {SpecValue} from "@/urlmaker/specs.ts";
+import type {Action, Filter, Specs, SpecValue, Transform} from "@/urlmaker/specs.ts";
+import {rssalchemy} from "@/urlmaker/proto/specs.ts";
 
-export type validator = (v: SpecValue) => boolean;
+export type validator = (v: SpecValue, specs: Specs) => boolean;
 
 export function validateUrl(s: SpecValue): boolean {
   let url;
@@ -17,19 +18,171 @@ export function validatePreset(s: SpecValue): boolean {
   return (s as string).startsWith(presetPrefix);
 }
 
-export function validateSelector(s: SpecValue): boolean {
+export const dialects = [
+  {label: 'CSS', value: rssalchemy.SelectorDialect.Css},
+  {label: 'XPath', value: rssalchemy.SelectorDialect.XPath},
+  {label: 'Playwright', value: rssalchemy.SelectorDialect.Playwright},
+];
+
+export function validateSelector(s: SpecValue, dialect = rssalchemy.SelectorDialect.Css): boolean {
+  switch (dialect) {
+    case rssalchemy.SelectorDialect.Css:
+      return validateCss(s as string);
+    case rssalchemy.SelectorDialect.XPath:
+      return validateXPath(s as string);
+    case rssalchemy.SelectorDialect.Playwright:
+      return validatePlaywright(s as string);
+    default:
+      return false;
+  }
+}
+
+function validateCss(s: string): boolean {
+  try {
+    document.createDocumentFragment().querySelector(s);
+    return true;
+  } catch {
+    return false;
+  }
+}
+
+function validateXPath(s: string): boolean {
   try {
-    document.createDocumentFragment().querySelector(s as string);
+    document.createExpression(s);
     return true;
   } catch {
     return false;
   }
 }
 
+// Playwright pseudo-classes are not known to browser, so they are removed before checking css.
+// Server does the full check, this one is only for quick feedback
+const playwrightPseudoClasses = /:(has-text|text-is|text-matches|text|visible|nth-match|left-of|right-of|above|below|near)(\((["'])?[^)]*?\3\))?/g;
+
+function validatePlaywright(s: string): boolean {
+  const parts = s.split(/>>(?=(?:[^"']|"[^"]*"|'[^']*')*$)/).map(part => part.trim());
+  return parts.every(part => {
+    const engine = part.match(/^([a-zA-Z0-9_+:*-]+)=(.*)$/);
+    if (engine) {
+      switch (engine[1]) {
+        case 'css':
+          part = engine[2];
+          break;
+        case 'xpath':
+          return validateXPath(engine[2]);
+        case 'nth':
+          return /^-?\d+$/.test(engine[2].trim());
+        case 'visible':
+          return ['true', 'false'].includes(engine[2].trim());
+        case 'text':
+        case 'id':
+        case 'data-testid':
+        case 'data-test-id':
+        case 'data-test':
+          return engine[2].trim().length > 0;
+        default:
+          return false;
+      }
+    }
+    if (part.startsWith('//') || part.startsWith('..')) {
+      return validateXPath(part);
+    }
+    if (part.startsWith('"') || part.startsWith("'")) {
+      return part.length > 1 && part.endsWith(part[0]);
+    }
+    if (!part) {
+      return false;
+    }
+    const css = part.replace(playwrightPseudoClasses, '').trim();
+    return validateCss(!css || /[>+~,]$/.test(css) ? css + '*' : css);
+  });
+}
+
 export function validateAttribute(s: SpecValue): boolean {
   return /([^\t\n\f \/>"'=]+)/.test(s as string);
 }
 
+// validateJsonQuery checks only basic syntax, queries are compiled by server
+export function validateJsonQuery(s: SpecValue, language: rssalchemy.JsonQueryLanguage): boolean {
+  const query = (s as string).trim();
+  if (!query || query.length > 500) {
+    return false;
+  }
+  return language !== rssalchemy.JsonQueryLanguage.JsonPath || query.startsWith('$');
+}
+
+export function validateJsonBody(s: SpecValue): boolean {
+  if (!s) {
+    return true;
+  }
+  try {
+    JSON.parse(s as string);
+    return true;
+  } catch {
+    return false;
+  }
+}
+
 export function validateDuration(s: SpecValue): boolean {
   return /^\d+[smh]$/.test(s as string);
 }
+
+export function validateNonNegativeInt(s: SpecValue): boolean {
+  return Number.isInteger(s) && (s as number) >= 0;
+}
+
+export const idFields = ['title', 'link', 'description', 'author', 'content', 'created'];
+
+export function validateIdFields(s: SpecValue): boolean {
+  return Array.isArray(s) && s.every(field => idFields.includes(field));
+}
+
+export function validateActions(s: SpecValue): boolean {
+  return Array.isArray(s) && s.length <= 20 && (s as Action[]).every(action => (
+    (!action.selector || validateSelector(action.selector, action.selector_dialect)) &&
+    validateNonNegativeInt(action.timeout_ms ?? 0) && (action.timeout_ms ?? 0) <= 30000
+  ));
+}
+
+export function validateFilters(s: SpecValue): boolean {
+  return Array.isArray(s) && s.length <= 20 && (s as Filter[]).every(filter => {
+    if (!filter.pattern || filter.pattern.length > 200) {
+      return false;
+    }
+    if (filter.match === rssalchemy.FilterMatch.Regex) {
+      try {
+        new RegExp(filter.pattern);
+      } catch {
+        return false;
+      }
+    }
+    return true;
+  });
+}
+
+export const transformFields = ['title', 'link', 'description', 'author', 'content', 'created', 'updated', 'enclosure'];
+
+export function validateTransforms(s: SpecValue): boolean {
+  return Array.isArray(s) && s.length <= 50 && (s as Transform[]).every(transform => {
+    if (!transformFields.includes(transform.field ?? '')) {
+      return false;
+    }
+    if ((transform.pattern?.length ?? 0) > 200 || (transform.value?.length ?? 0) > 500) {
+      return false;
+    }
+    switch (transform.type) {
+      case rssalchemy.TransformType.RegexExtract:
+      case rssalchemy.TransformType.RegexReplace:
+        try {
+          new RegExp(transform.pattern ?? '');
+        } catch {
+          return false;
+        }
+        return true;
+      case rssalchemy.TransformType.Template:
+        return transform.field !== 'created' && transform.field !== 'updated' && !!transform.value;
+      default:
+        return true;
+    }
+  });
+}
@@ -3,6 +3,7 @@ package adapters
 import (
 	"context"
 	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
 	"time"
 )
 
@@ -23,3 +24,20 @@ type QueueConsumer interface {
 		taskFunc func(taskPayload []byte) (cacheKey string, result []byte, err error),
 	) error
 }
+
+// ArchivedItem is a feed item with id by which archive deduplicates items
+type ArchivedItem struct {
+	Id   string
+	Item models.FeedItem
+}
+
+type ArchiveLimits struct {
+	MaxItems int           // 0 means unlimited
+	MaxAge   time.Duration // 0 means unlimited
+}
+
+type ItemArchive interface {
+	// Merge adds items to archive (replacing archived items with the same id)
+	// and returns all archived items, newest first
+	Merge(key string, items []ArchivedItem, limits ArchiveLimits) ([]ArchivedItem, error)
+}
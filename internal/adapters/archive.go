@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// MergeItems is the merge algorithm for ItemArchive implementations.
+// New items replace archived ones with the same id and are never removed by MaxAge
+// (they are still on the page), other items are removed if created earlier than MaxAge ago.
+// Result is sorted by creation date, newest first, and truncated to MaxItems.
+func MergeItems(archived []ArchivedItem, items []ArchivedItem, limits ArchiveLimits, now time.Time) []ArchivedItem {
+	merged := make([]ArchivedItem, 0, len(archived)+len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		if _, ok := seen[item.Id]; ok {
+			continue
+		}
+		seen[item.Id] = struct{}{}
+		merged = append(merged, item)
+	}
+	for _, item := range archived {
+		if _, ok := seen[item.Id]; ok {
+			continue
+		}
+		if limits.MaxAge > 0 && now.Sub(item.Item.Created) > limits.MaxAge {
+			continue
+		}
+		seen[item.Id] = struct{}{}
+		merged = append(merged, item)
+	}
+	slices.SortStableFunc(merged, func(a, b ArchivedItem) int {
+		return b.Item.Created.Compare(a.Item.Created)
+	})
+	if limits.MaxItems > 0 && len(merged) > limits.MaxItems {
+		merged = merged[:limits.MaxItems]
+	}
+	return merged
+}
+
+// MarshalItems encodes items as json array not longer than maxBytes (0 means unlimited).
+// Items are sorted newest first, so the oldest ones which do not fit are dropped; returned items are the encoded ones.
+// Archive of full texts can easily outgrow storage value limit, dropping old items keeps it working
+func MarshalItems(items []ArchivedItem, maxBytes int) ([]byte, []ArchivedItem, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, item := range items {
+		encoded, err := json.Marshal(item)
+		if err != nil {
+			return nil, nil, fmt.Errorf("marshal item %s: %w", item.Id, err)
+		}
+		if maxBytes > 0 && buf.Len()+len(encoded)+2 > maxBytes { // separator and closing bracket
+			if i == 0 {
+				return nil, nil, fmt.Errorf("item %s is longer than %d bytes", item.Id, maxBytes)
+			}
+			items = items[:i]
+			break
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(encoded)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), items, nil
+}
@@ -0,0 +1,108 @@
+package adapters
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC)
+
+func archivedItem(id string, title string, age time.Duration) ArchivedItem {
+	return ArchivedItem{Id: id, Item: models.FeedItem{Title: title, Created: now.Add(-age)}}
+}
+
+func ids(items []ArchivedItem) []string {
+	result := make([]string, len(items))
+	for i, item := range items {
+		result[i] = item.Id
+	}
+	return result
+}
+
+func TestMergeItems(t *testing.T) {
+	archived := []ArchivedItem{
+		archivedItem("a", "old title", 3*time.Hour),
+		archivedItem("b", "b", 2*24*time.Hour),
+		archivedItem("c", "c", 10*24*time.Hour),
+	}
+	items := []ArchivedItem{
+		archivedItem("d", "d", 1*time.Hour),
+		archivedItem("a", "new title", 3*time.Hour),
+		archivedItem("d", "duplicate", 1*time.Hour),
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		merged := MergeItems(archived, items, ArchiveLimits{}, now)
+		assert.Equal(t, []string{"d", "a", "b", "c"}, ids(merged))
+		assert.Equal(t, "new title", merged[1].Item.Title)
+		assert.Equal(t, "d", merged[0].Item.Title)
+	})
+
+	t.Run("max items", func(t *testing.T) {
+		merged := MergeItems(archived, items, ArchiveLimits{MaxItems: 3}, now)
+		assert.Equal(t, []string{"d", "a", "b"}, ids(merged))
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		merged := MergeItems(archived, items, ArchiveLimits{MaxAge: 7 * 24 * time.Hour}, now)
+		assert.Equal(t, []string{"d", "a", "b"}, ids(merged))
+	})
+
+	t.Run("max age keeps current items", func(t *testing.T) {
+		merged := MergeItems(archived, []ArchivedItem{archivedItem("e", "e", 30*24*time.Hour)}, ArchiveLimits{MaxAge: 24 * time.Hour}, now)
+		assert.Equal(t, []string{"a", "e"}, ids(merged))
+	})
+
+	t.Run("empty archive", func(t *testing.T) {
+		merged := MergeItems(nil, items, ArchiveLimits{}, now)
+		assert.Equal(t, []string{"d", "a"}, ids(merged))
+	})
+}
+
+func TestMarshalItems(t *testing.T) {
+	items := make([]ArchivedItem, 5)
+	for i := range items {
+		items[i] = archivedItem(string(rune('a'+i)), "title", time.Duration(i)*time.Hour)
+		items[i].Item.Content = strings.Repeat("x", 1000) // full text
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		payload, stored, err := MarshalItems(items, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, ids(stored))
+		expected, err := json.Marshal(items)
+		require.NoError(t, err)
+		assert.Equal(t, expected, payload)
+	})
+
+	t.Run("oldest dropped", func(t *testing.T) {
+		single, err := json.Marshal(items[0])
+		require.NoError(t, err)
+		limit := 3*len(single) + 4 // three items with brackets and commas
+		payload, stored, err := MarshalItems(items, limit)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(payload), limit)
+		assert.Equal(t, []string{"a", "b", "c"}, ids(stored))
+		var decoded []ArchivedItem
+		require.NoError(t, json.Unmarshal(payload, &decoded))
+		assert.Equal(t, ids(stored), ids(decoded))
+	})
+
+	t.Run("newest too large", func(t *testing.T) {
+		_, _, err := MarshalItems(items, 500)
+		assert.Error(t, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		payload, stored, err := MarshalItems(nil, 100)
+		require.NoError(t, err)
+		assert.Equal(t, "[]", string(payload))
+		assert.Empty(t, stored)
+	})
+}
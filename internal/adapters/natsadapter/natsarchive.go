@@ -0,0 +1,109 @@
+package natsadapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/adapters"
+	"github.com/labstack/gommon/log"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"time"
+)
+
+const (
+	// maxMergeAttempts limits retries when archive is concurrently updated by another webserver
+	maxMergeAttempts = 5
+	// Archives of feeds which were not requested for this long are removed.
+	// Bucket TTL counts from last write, so unchanged archives are rewritten not more often than archiveRefresh
+	archiveTTL     = 90 * 24 * time.Hour
+	archiveRefresh = 24 * time.Hour
+	// payloadReserve is left of server max payload for message headers
+	payloadReserve = 4096
+)
+
+type NatsArchive struct {
+	kv         jetstream.KeyValue
+	maxPayload int // archive value limit, oldest items are dropped to fit
+}
+
+func NewArchive(natsc *nats.Conn) (*NatsArchive, error) {
+	a := NatsArchive{}
+
+	a.maxPayload = int(natsc.MaxPayload()) - payloadReserve
+	if a.maxPayload <= 0 {
+		return nil, fmt.Errorf("nats max payload %d is too small", natsc.MaxPayload())
+	}
+
+	jets, err := jetstream.New(natsc)
+	if err != nil {
+		return nil, fmt.Errorf("create jetstream: %w", err)
+	}
+
+	// update, because bucket of older versions has no ttl
+	a.kv, err = jets.CreateOrUpdateKeyValue(context.TODO(), jetstream.KeyValueConfig{
+		Bucket: "item_archive",
+		TTL:    archiveTTL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create nats kv: %w", err)
+	}
+
+	return &a, nil
+}
+
+func (a *NatsArchive) Merge(
+	key string,
+	items []adapters.ArchivedItem,
+	limits adapters.ArchiveLimits,
+) ([]adapters.ArchivedItem, error) {
+	for attempt := 0; attempt < maxMergeAttempts; attempt++ {
+		var archived []adapters.ArchivedItem
+		var current []byte
+		var revision uint64
+		var written time.Time
+		entry, err := a.kv.Get(context.TODO(), key)
+		if err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, fmt.Errorf("nats: %w", err)
+		}
+		if err == nil {
+			current = entry.Value()
+			revision = entry.Revision()
+			written = entry.Created()
+			if err := json.Unmarshal(current, &archived); err != nil {
+				log.Errorf("archive %s is corrupted, overwriting: %v", key, err)
+				archived = nil
+			}
+		}
+
+		merged := adapters.MergeItems(archived, items, limits, time.Now())
+		payload, stored, err := adapters.MarshalItems(merged, a.maxPayload)
+		if err != nil {
+			return nil, fmt.Errorf("marshal archive: %w", err)
+		}
+		if len(stored) < len(merged) {
+			log.Warnf("archive %s exceeds %d bytes, dropped %d oldest items", key, a.maxPayload, len(merged)-len(stored))
+			merged = stored
+		}
+		if bytes.Equal(payload, current) && time.Since(written) < archiveRefresh {
+			return merged, nil
+		}
+
+		if revision == 0 {
+			_, err = a.kv.Create(context.TODO(), key, payload)
+		} else {
+			_, err = a.kv.Update(context.TODO(), key, payload, revision)
+		}
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			log.Debugf("archive %s concurrently updated, retrying", key)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("nats: %w", err)
+		}
+		return merged, nil
+	}
+	return nil, fmt.Errorf("archive merge failed after %d attempts", maxMergeAttempts)
+}
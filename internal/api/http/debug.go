@@ -0,0 +1,76 @@
+package http
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/echo/v4"
+	"strings"
+)
+
+// debugResponse is screenshot with outlined selector matches and report of match counts
+type debugResponse struct {
+	Image     string           `json:"image"` // png data url
+	Selectors []selectorReport `json:"selectors"`
+	Filters   *filterReport    `json:"filters,omitempty"` // only if specs have filters
+}
+
+type selectorReport struct {
+	Field    string `json:"field"`
+	Selector string `json:"selector"`
+	Matches  int    `json:"matches"`
+	Posts    int    `json:"posts"`
+	Error    string `json:"error,omitempty"`
+}
+
+// handleDebugScreenshot runs specs like render does, but returns annotated screenshot instead of feed.
+// With format=png only image is returned
+func (h *Handler) handleDebugScreenshot(c echo.Context) error {
+	specs, err := h.decodeSpecs(c.Param("specs"))
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Errorf("decode specs: %w", err))
+	}
+	task, err := makeTask(c, specs)
+	if err != nil {
+		return err
+	}
+	filters, err := compileFilters(specs.Filters)
+	if err != nil {
+		return echo.NewHTTPError(400, err.Error())
+	}
+	if task.TaskType == models.TaskTypeJsonApi {
+		return echo.NewHTTPError(400, "debug screenshot is not available for json api source")
+	}
+	task.TaskType = models.TaskTypeDebug
+
+	var result models.DebugTaskResult
+	if err := h.runTask(c, task, &result); err != nil {
+		return err
+	}
+	if strings.ToLower(c.QueryParam("format")) == "png" {
+		return c.Blob(200, "image/png", result.Image)
+	}
+	resp := makeDebugResponse(result)
+	if len(filters) > 0 {
+		report := makeFilterReport(result.Items, specs.Filters, filters, specs.FilterMode)
+		resp.Filters = &report
+	}
+	return c.JSON(200, resp)
+}
+
+func makeDebugResponse(result models.DebugTaskResult) debugResponse {
+	resp := debugResponse{
+		Image:     "data:image/png;base64," + base64.StdEncoding.EncodeToString(result.Image),
+		Selectors: make([]selectorReport, len(result.Selectors)),
+	}
+	for i, report := range result.Selectors {
+		resp.Selectors[i] = selectorReport{
+			Field:    report.Field,
+			Selector: report.Selector,
+			Matches:  report.Matches,
+			Posts:    report.Posts,
+			Error:    report.Error,
+		}
+	}
+	return resp
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeDebugResponse(t *testing.T) {
+	resp := makeDebugResponse(models.DebugTaskResult{
+		Image: []byte{0x89, 'P', 'N', 'G'},
+		Selectors: []models.SelectorReport{
+			{Field: "post", Selector: "article", Matches: 10},
+			{Field: "title", Selector: "h2", Matches: 9, Posts: 9},
+			{Field: "link", Selector: "a[", Error: "invalid selector"},
+		},
+	})
+	assert.Equal(t, "data:image/png;base64,iVBORw==", resp.Image)
+
+	encoded, err := json.Marshal(resp)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, []any{
+		map[string]any{"field": "post", "selector": "article", "matches": float64(10), "posts": float64(0)},
+		map[string]any{"field": "title", "selector": "h2", "matches": float64(9), "posts": float64(9)},
+		map[string]any{
+			"field": "link", "selector": "a[", "matches": float64(0), "posts": float64(0), "error": "invalid selector",
+		},
+	}, decoded["selectors"])
+}
@@ -0,0 +1,160 @@
+package http
+
+import (
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/api/http/pb"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"regexp"
+	"strings"
+)
+
+// itemFilter is compiled pb.Filter
+type itemFilter struct {
+	field   pb.FilterField
+	exclude bool
+	match   func(value string) bool
+}
+
+func compileFilters(specFilters []*pb.Filter) ([]itemFilter, error) {
+	var filters []itemFilter
+	for i, specFilter := range specFilters {
+		if _, ok := pb.FilterField_name[int32(specFilter.Field)]; !ok {
+			return nil, fmt.Errorf("filter %d: invalid field", i+1)
+		}
+		filter := itemFilter{field: specFilter.Field, exclude: specFilter.Exclude}
+		switch specFilter.Match {
+		case pb.FilterMatch_Substring:
+			pattern := specFilter.Pattern
+			if specFilter.CaseSensitive {
+				filter.match = func(value string) bool {
+					return strings.Contains(value, pattern)
+				}
+			} else {
+				pattern = strings.ToLower(pattern)
+				filter.match = func(value string) bool {
+					return strings.Contains(strings.ToLower(value), pattern)
+				}
+			}
+		case pb.FilterMatch_Regex:
+			pattern := specFilter.Pattern
+			if !specFilter.CaseSensitive {
+				pattern = "(?i)" + pattern
+			}
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("filter %d: compile regex: %w", i+1, err)
+			}
+			filter.match = re.MatchString
+		default:
+			return nil, fmt.Errorf("filter %d: invalid match", i+1)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// filterItems keeps items matching include filters (all or any of them, according to mode)
+// and not matching any exclude filter. Returns kept items and count of filtered out
+func filterItems(items []models.FeedItem, filters []itemFilter, mode pb.FilterMode) ([]models.FeedItem, int) {
+	if len(filters) == 0 {
+		return items, 0
+	}
+	kept := make([]models.FeedItem, 0, len(items))
+	for _, item := range items {
+		if keepItem(item, filters, mode) {
+			kept = append(kept, item)
+		}
+	}
+	return kept, len(items) - len(kept)
+}
+
+func keepItem(item models.FeedItem, filters []itemFilter, mode pb.FilterMode) bool {
+	hasInclude := false
+	anyIncluded := false
+	allIncluded := true
+	for _, filter := range filters {
+		matched := filter.match(filterField(item, filter.field))
+		if filter.exclude {
+			if matched {
+				return false
+			}
+			continue
+		}
+		hasInclude = true
+		anyIncluded = anyIncluded || matched
+		allIncluded = allIncluded && matched
+	}
+	if !hasInclude {
+		return true
+	}
+	if mode == pb.FilterMode_Any {
+		return anyIncluded
+	}
+	return allIncluded
+}
+
+func filterField(item models.FeedItem, field pb.FilterField) string {
+	switch field {
+	case pb.FilterField_Title:
+		return item.Title
+	case pb.FilterField_Description:
+		return item.Description
+	case pb.FilterField_Content:
+		return item.Content
+	case pb.FilterField_Author:
+		return item.AuthorName
+	case pb.FilterField_Link:
+		return item.Link
+	default:
+		return ""
+	}
+}
+
+// filterReport tells how filters change extracted items, so filtered out posts can be told from broken selectors
+type filterReport struct {
+	Items int                `json:"items"` // extracted
+	Kept  int                `json:"kept"`
+	Rules []filterRuleReport `json:"rules"`
+}
+
+type filterRuleReport struct {
+	Field   string `json:"field"`
+	Pattern string `json:"pattern"`
+	Exclude bool   `json:"exclude"`
+	Matches int    `json:"matches"`
+	Dropped int    `json:"dropped"` // items this rule drops by itself: matched exclude or not matched include
+}
+
+// makeFilterReport counts matches of each filter, specFilters and filters must be of the same specs
+func makeFilterReport(
+	items []models.FeedItem,
+	specFilters []*pb.Filter,
+	filters []itemFilter,
+	mode pb.FilterMode,
+) filterReport {
+	kept, _ := filterItems(items, filters, mode)
+	report := filterReport{
+		Items: len(items),
+		Kept:  len(kept),
+		Rules: make([]filterRuleReport, len(filters)),
+	}
+	for i, filter := range filters {
+		rule := filterRuleReport{
+			Field:   strings.ToLower(filter.field.String()),
+			Pattern: specFilters[i].Pattern,
+			Exclude: filter.exclude,
+		}
+		for _, item := range items {
+			if filter.match(filterField(item, filter.field)) {
+				rule.Matches++
+			}
+		}
+		if filter.exclude {
+			rule.Dropped = rule.Matches
+		} else {
+			rule.Dropped = len(items) - rule.Matches
+		}
+		report.Rules[i] = rule
+	}
+	return report
+}
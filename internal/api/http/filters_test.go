@@ -0,0 +1,104 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/egor3f/rssalchemy/internal/api/http/pb"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterItems(t *testing.T) {
+	items := []models.FeedItem{
+		{Title: "Go 1.24 released", Link: "https://example.com/go", AuthorName: "Alice"},
+		{Title: "Sponsored: buy now", Link: "https://example.com/ad", AuthorName: "Bob"},
+		{Title: "Rust news", Link: "https://example.com/rust", AuthorName: "alice"},
+	}
+	tests := []struct {
+		name     string
+		filters  []*pb.Filter
+		mode     pb.FilterMode
+		expected []string // links of kept items
+	}{
+		{"no filters", nil, pb.FilterMode_All, []string{"go", "ad", "rust"}},
+		{
+			"exclude substring",
+			[]*pb.Filter{{Pattern: "sponsored", Exclude: true}},
+			pb.FilterMode_All,
+			[]string{"go", "rust"},
+		},
+		{
+			"include case sensitive",
+			[]*pb.Filter{{Field: pb.FilterField_Author, Pattern: "Alice", CaseSensitive: true}},
+			pb.FilterMode_All,
+			[]string{"go"},
+		},
+		{
+			"include regex",
+			[]*pb.Filter{{Field: pb.FilterField_Link, Match: pb.FilterMatch_Regex, Pattern: `/(go|rust)$`}},
+			pb.FilterMode_All,
+			[]string{"go", "rust"},
+		},
+		{
+			"include all",
+			[]*pb.Filter{{Field: pb.FilterField_Author, Pattern: "alice"}, {Pattern: "news"}},
+			pb.FilterMode_All,
+			[]string{"rust"},
+		},
+		{
+			"include any with exclude",
+			[]*pb.Filter{{Pattern: "go"}, {Pattern: "buy"}, {Field: pb.FilterField_Author, Pattern: "bob", Exclude: true}},
+			pb.FilterMode_Any,
+			[]string{"go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters, err := compileFilters(tt.filters)
+			require.NoError(t, err)
+			kept, filtered := filterItems(items, filters, tt.mode)
+			var links []string
+			for _, item := range kept {
+				links = append(links, item.Link[len("https://example.com/"):])
+			}
+			assert.Equal(t, tt.expected, links)
+			assert.Equal(t, len(items)-len(tt.expected), filtered)
+		})
+	}
+}
+
+func TestCompileFiltersInvalid(t *testing.T) {
+	_, err := compileFilters([]*pb.Filter{{Match: pb.FilterMatch_Regex, Pattern: "("}})
+	assert.ErrorContains(t, err, "filter 1")
+	_, err = compileFilters([]*pb.Filter{{Field: 100, Pattern: "a"}})
+	assert.Error(t, err)
+}
+
+func TestMakeFilterReport(t *testing.T) {
+	items := []models.FeedItem{
+		{Title: "Go 1.24 released", AuthorName: "Alice"},
+		{Title: "Sponsored: buy now", AuthorName: "Bob"},
+		{Title: "Rust news", AuthorName: "alice"},
+	}
+	specFilters := []*pb.Filter{
+		{Pattern: "sponsored", Exclude: true},
+		{Field: pb.FilterField_Author, Pattern: "alice"},
+	}
+	filters, err := compileFilters(specFilters)
+	require.NoError(t, err)
+
+	report := makeFilterReport(items, specFilters, filters, pb.FilterMode_All)
+	assert.Equal(t, filterReport{
+		Items: 3,
+		Kept:  2,
+		Rules: []filterRuleReport{
+			{Field: "title", Pattern: "sponsored", Exclude: true, Matches: 1, Dropped: 1},
+			{Field: "author", Pattern: "alice", Matches: 2, Dropped: 1},
+		},
+	}, report)
+
+	report = makeFilterReport(nil, specFilters, filters, pb.FilterMode_All)
+	assert.Equal(t, 0, report.Items)
+	assert.Len(t, report.Rules, 2)
+}
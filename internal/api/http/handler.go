@@ -2,15 +2,19 @@ package http
 
 import (
 	"bytes"
+	"cmp"
 	"compress/flate"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/egor3f/rssalchemy/internal/adapters"
 	"github.com/egor3f/rssalchemy/internal/api/http/pb"
+	"github.com/egor3f/rssalchemy/internal/jsonquery"
 	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/egor3f/rssalchemy/internal/transform"
 	"github.com/egor3f/rssalchemy/internal/validators"
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/feeds"
@@ -20,6 +24,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"html"
 	"io"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -28,15 +33,22 @@ import (
 )
 
 const (
-	taskTimeout = 1 * time.Minute
-	minLifetime = time.Duration(0)
-	maxLifetime = 24 * time.Hour
+	taskTimeout     = 1 * time.Minute
+	minLifetime     = time.Duration(0)
+	maxLifetime     = 24 * time.Hour
+	maxArchiveItems = 200
 )
 
+type Sanitizer interface {
+	Sanitize(fragment string, baseUrl string) (string, error)
+}
+
 type Handler struct {
 	validate       *validator.Validate
 	workQueue      adapters.WorkQueue
 	cache          adapters.Cache
+	archive        adapters.ItemArchive
+	sanitizer      Sanitizer
 	rateLimit      rate.Limit
 	rateLimitBurst int
 	limits         map[string]*rate.Limiter
@@ -44,13 +56,23 @@ type Handler struct {
 	debug          bool
 }
 
-func New(wq adapters.WorkQueue, cache adapters.Cache, rateLimit rate.Limit, rateLimitBurst int, debug bool) *Handler {
-	if wq == nil || cache == nil {
+func New(
+	wq adapters.WorkQueue,
+	cache adapters.Cache,
+	archive adapters.ItemArchive,
+	sanitizer Sanitizer,
+	rateLimit rate.Limit,
+	rateLimitBurst int,
+	debug bool,
+) *Handler {
+	if wq == nil || cache == nil || archive == nil || sanitizer == nil {
 		panic("you fckd up with di again")
 	}
 	h := Handler{
 		workQueue:      wq,
 		cache:          cache,
+		archive:        archive,
+		sanitizer:      sanitizer,
 		rateLimit:      rateLimit,
 		rateLimitBurst: rateLimitBurst,
 		limits:         make(map[string]*rate.Limiter),
@@ -66,6 +88,9 @@ func New(wq adapters.WorkQueue, cache adapters.Cache, rateLimit rate.Limit, rate
 func (h *Handler) SetupRoutes(g *echo.Group) {
 	g.GET("/render/:specs", h.handleRender)
 	g.GET("/screenshot", h.handlePageScreenshot)
+	g.GET("/suggest", h.handleSuggestSelectors)
+	g.GET("/snapshot", h.handlePageSnapshot)
+	g.GET("/debug/:specs", h.handleDebugScreenshot)
 }
 
 func (h *Handler) handleRender(c echo.Context) error {
@@ -75,28 +100,30 @@ func (h *Handler) handleRender(c echo.Context) error {
 		return echo.NewHTTPError(400, fmt.Errorf("decode specs: %w", err))
 	}
 
-	extractFrom, ok := map[pb.ExtractFrom]models.ExtractFrom{
-		pb.ExtractFrom_InnerText: models.ExtractFrom_InnerText,
-		pb.ExtractFrom_Attribute: models.ExtractFrom_Attribute,
-	}[specs.CreatedExtractFrom]
-	if !ok {
-		return echo.NewHTTPError(400, "invalid extract from")
+	task, err := makeTask(c, specs)
+	if err != nil {
+		return err
 	}
 
-	task := models.Task{
-		TaskType:             models.TaskTypeExtract,
-		URL:                  specs.Url,
-		SelectorPost:         specs.SelectorPost,
-		SelectorTitle:        specs.SelectorTitle,
-		SelectorLink:         specs.SelectorLink,
-		SelectorDescription:  specs.SelectorDescription,
-		SelectorAuthor:       specs.SelectorAuthor,
-		SelectorCreated:      specs.SelectorCreated,
-		CreatedExtractFrom:   extractFrom,
-		CreatedAttributeName: specs.CreatedAttributeName,
-		SelectorContent:      specs.SelectorContent,
-		SelectorEnclosure:    specs.SelectorEnclosure,
-		Headers:              extractHeaders(c),
+	filters, err := compileFilters(specs.Filters)
+	if err != nil {
+		return echo.NewHTTPError(400, err.Error())
+	}
+
+	format := specs.Format
+	if acceptFormat, ok := negotiateFormat(c.Request().Header.Get("Accept"), format); ok {
+		format = acceptFormat
+	}
+	if formatParam := c.QueryParam("format"); len(formatParam) > 0 {
+		var ok bool
+		format, ok = feedFormats[strings.ToLower(formatParam)]
+		if !ok {
+			return echo.NewHTTPError(400, "invalid feed format")
+		}
+	}
+	renderer, ok := feedRenderers[format]
+	if !ok {
+		return echo.NewHTTPError(400, "invalid feed format")
 	}
 
 	cacheLifetime, err := time.ParseDuration(specs.CacheLifetime)
@@ -134,6 +161,7 @@ func (h *Handler) handleRender(c echo.Context) error {
 		if err != nil {
 			return echo.NewHTTPError(500, fmt.Errorf("task enqueue failed: %v", err))
 		}
+		cachedTS = time.Now()
 	}
 
 	var result models.TaskResult
@@ -141,28 +169,273 @@ func (h *Handler) handleRender(c echo.Context) error {
 		return echo.NewHTTPError(500, fmt.Errorf("cached value unmarshal failed: %v", err))
 	}
 
-	atom, err := makeFeed(task, result)
+	if specs.ArchiveItems > 0 || specs.ArchiveDays > 0 {
+		limits := adapters.ArchiveLimits{
+			MaxItems: min(int(specs.ArchiveItems), maxArchiveItems),
+			MaxAge:   time.Duration(specs.ArchiveDays) * 24 * time.Hour,
+		}
+		if limits.MaxItems == 0 {
+			limits.MaxItems = maxArchiveItems
+		}
+		archived, err := h.mergeArchive(task, result.Items, limits)
+		if err != nil {
+			log.Errorf("merge archive failed, serving only current items: %v", err)
+		} else {
+			result.Items = archived
+		}
+	}
+
+	if len(filters) > 0 {
+		total := len(result.Items)
+		var filtered int
+		result.Items, filtered = filterItems(result.Items, filters, specs.FilterMode)
+		if total > 0 && filtered == total {
+			log.Warnf("Filters dropped all %d items, url=%s", total, task.URL)
+		} else {
+			log.Infof("Filters dropped %d of %d items, url=%s", filtered, total, task.URL)
+		}
+		c.Response().Header().Set("X-Filtered-Items", fmt.Sprintf("%d/%d", filtered, total))
+	}
+
+	h.sanitizeItems(task, result.Items)
+
+	f, err := makeFeed(task, result, cachedTS)
 	if err != nil {
 		log.Errorf("make feed failed: %v", err)
 		return echo.NewHTTPError(500)
 	}
+	rendered, err := renderer.Render(f)
+	if err != nil {
+		log.Errorf("render feed failed: %v", err)
+		return echo.NewHTTPError(500)
+	}
+
+	// etag is made of rendered feed, because archive and filters change it without changing cached result
+	etag := makeETag(rendered)
+	respHeader := c.Response().Header()
+	respHeader.Set(echo.HeaderVary, echo.HeaderAccept)
+	respHeader.Set("ETag", etag)
+	respHeader.Set(echo.HeaderLastModified, cachedTS.UTC().Format(http.TimeFormat))
+	maxAge := max(cacheLifetime-time.Since(cachedTS), 0)
+	respHeader.Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds())))
+	if isNotModified(c.Request(), etag, cachedTS) {
+		return c.NoContent(http.StatusNotModified)
+	}
 
-	c.Response().Header().Set("Content-Type", "text/xml")
-	return c.String(200, atom)
+	respHeader.Set("Content-Type", renderer.ContentType())
+	return c.String(200, rendered)
+}
+
+// makeTask converts specs to task, returned errors are http errors
+func makeTask(c echo.Context, specs *pb.Specs) (models.Task, error) {
+	if err := convertSelectors(specs); err != nil {
+		return models.Task{}, echo.NewHTTPError(400, err.Error())
+	}
+
+	titleFrom, err := makeExtractFrom(specs.TitleExtractFrom)
+	if err != nil {
+		return models.Task{}, echo.NewHTTPError(400, fmt.Sprintf("title: %v", err))
+	}
+	linkFrom, err := makeExtractFrom(specs.LinkExtractFrom)
+	if err != nil {
+		return models.Task{}, echo.NewHTTPError(400, fmt.Sprintf("link: %v", err))
+	}
+	descriptionFrom, err := makeExtractFrom(specs.DescriptionExtractFrom)
+	if err != nil {
+		return models.Task{}, echo.NewHTTPError(400, fmt.Sprintf("description: %v", err))
+	}
+	authorFrom, err := makeExtractFrom(specs.AuthorExtractFrom)
+	if err != nil {
+		return models.Task{}, echo.NewHTTPError(400, fmt.Sprintf("author: %v", err))
+	}
+	createdFrom, err := makeExtractFrom(specs.CreatedExtractFrom)
+	if err != nil {
+		return models.Task{}, echo.NewHTTPError(400, fmt.Sprintf("created: %v", err))
+	}
+	updatedFrom, err := makeExtractFrom(specs.UpdatedExtractFrom)
+	if err != nil {
+		return models.Task{}, echo.NewHTTPError(400, fmt.Sprintf("updated: %v", err))
+	}
+	enclosureFrom, err := makeExtractFrom(specs.EnclosureExtractFrom)
+	if err != nil {
+		return models.Task{}, echo.NewHTTPError(400, fmt.Sprintf("enclosure: %v", err))
+	}
+
+	idStrategy, ok := map[pb.IdStrategy]models.IdStrategy{
+		pb.IdStrategy_LinkDate:   models.IdStrategy_LinkDate,
+		pb.IdStrategy_LinkHash:   models.IdStrategy_LinkHash,
+		pb.IdStrategy_Selector:   models.IdStrategy_Selector,
+		pb.IdStrategy_FieldsHash: models.IdStrategy_FieldsHash,
+	}[specs.IdStrategy]
+	if !ok {
+		return models.Task{}, echo.NewHTTPError(400, "invalid id strategy")
+	}
+
+	actions, err := makeActions(specs.Actions)
+	if err != nil {
+		return models.Task{}, echo.NewHTTPError(400, err.Error())
+	}
+
+	transforms, err := makeTransforms(specs.Transforms)
+	if err != nil {
+		return models.Task{}, echo.NewHTTPError(400, err.Error())
+	}
+
+	fullTextMode, ok := map[pb.FullTextMode]models.FullTextMode{
+		pb.FullTextMode_Off:        models.FullTextMode_Off,
+		pb.FullTextMode_BySelector: models.FullTextMode_BySelector,
+		pb.FullTextMode_Auto:       models.FullTextMode_Auto,
+	}[specs.FulltextMode]
+	if !ok {
+		return models.Task{}, echo.NewHTTPError(400, "invalid full text mode")
+	}
+
+	renderMode, ok := map[pb.RenderMode]models.RenderMode{
+		pb.RenderMode_Browser:         models.RenderMode_Browser,
+		pb.RenderMode_Static:          models.RenderMode_Static,
+		pb.RenderMode_StaticOrBrowser: models.RenderMode_Auto,
+	}[specs.RenderMode]
+	if !ok {
+		return models.Task{}, echo.NewHTTPError(400, "invalid render mode")
+	}
+	if renderMode == models.RenderMode_Static && (len(actions) > 0 || specs.ScrollSteps > 0) {
+		return models.Task{}, echo.NewHTTPError(400, "static render mode does not support actions and scrolling")
+	}
+
+	structuredData, ok := map[pb.StructuredDataMode]models.StructuredDataMode{
+		pb.StructuredDataMode_StructuredDataOff:      models.StructuredDataMode_Off,
+		pb.StructuredDataMode_StructuredDataFallback: models.StructuredDataMode_Fallback,
+		pb.StructuredDataMode_StructuredDataOnly:     models.StructuredDataMode_Only,
+	}[specs.StructuredData]
+	if !ok {
+		return models.Task{}, echo.NewHTTPError(400, "invalid structured data mode")
+	}
+
+	taskType, ok := map[pb.SourceType]models.TaskType{
+		pb.SourceType_Page:    models.TaskTypeExtract,
+		pb.SourceType_JsonApi: models.TaskTypeJsonApi,
+	}[specs.SourceType]
+	if !ok {
+		return models.Task{}, echo.NewHTTPError(400, "invalid source type")
+	}
+	var jsonMapping models.JsonMapping
+	if taskType == models.TaskTypeJsonApi {
+		jsonMapping, err = makeJsonMapping(specs)
+		if err != nil {
+			return models.Task{}, echo.NewHTTPError(400, err.Error())
+		}
+	}
+
+	return models.Task{
+		TaskType:                 taskType,
+		URL:                      specs.Url,
+		RenderMode:               renderMode,
+		StructuredData:           structuredData,
+		SelectorPost:             specs.SelectorPost,
+		SelectorTitle:            specs.SelectorTitle,
+		TitleExtractFrom:         titleFrom,
+		TitleAttributeName:       specs.TitleAttributeName,
+		SelectorLink:             specs.SelectorLink,
+		LinkExtractFrom:          linkFrom,
+		LinkAttributeName:        specs.LinkAttributeName,
+		SelectorDescription:      specs.SelectorDescription,
+		DescriptionExtractFrom:   descriptionFrom,
+		DescriptionAttributeName: specs.DescriptionAttributeName,
+		SelectorAuthor:           specs.SelectorAuthor,
+		AuthorExtractFrom:        authorFrom,
+		AuthorAttributeName:      specs.AuthorAttributeName,
+		SelectorCreated:          specs.SelectorCreated,
+		CreatedExtractFrom:       createdFrom,
+		CreatedAttributeName:     specs.CreatedAttributeName,
+		SelectorUpdated:          specs.SelectorUpdated,
+		UpdatedExtractFrom:       updatedFrom,
+		UpdatedAttributeName:     specs.UpdatedAttributeName,
+		SelectorContent:          specs.SelectorContent,
+		SelectorEnclosure:        specs.SelectorEnclosure,
+		EnclosureExtractFrom:     enclosureFrom,
+		EnclosureAttributeName:   specs.EnclosureAttributeName,
+		SelectorId:               specs.SelectorId,
+		IdAttributeName:          specs.IdAttributeName,
+		FullTextMode:             fullTextMode,
+		SelectorFullText:         specs.SelectorFulltext,
+		SelectorNextPage:         specs.SelectorNextPage,
+		MaxPages:                 int(specs.MaxPages),
+		ScrollSteps:              int(specs.ScrollSteps),
+		SelectorLoadMore:         specs.SelectorLoadMore,
+		TargetItems:              int(specs.TargetItems),
+		Actions:                  actions,
+		Transforms:               transforms,
+		Json:                     jsonMapping,
+		Headers:                  extractHeaders(c),
+		IdStrategy:               idStrategy,
+		IdFields:                 specs.IdFields,
+		FeedTitle:                specs.FeedTitle,
+		FeedSubtitle:             specs.FeedSubtitle,
+	}, nil
+}
+
+// mergeArchive adds items to feed archive and returns all archived items
+func (h *Handler) mergeArchive(task models.Task, items []models.FeedItem, limits adapters.ArchiveLimits) ([]models.FeedItem, error) {
+	newItems := make([]adapters.ArchivedItem, 0, len(items))
+	for _, item := range items {
+		id, err := makeItemId(task, item)
+		if err != nil {
+			log.Errorf("Invalid item link, item=%+v", item)
+			continue
+		}
+		newItems = append(newItems, adapters.ArchivedItem{Id: id, Item: item})
+	}
+	archived, err := h.archive.Merge(archiveKey(task), newItems, limits)
+	if err != nil {
+		return nil, fmt.Errorf("archive merge: %w", err)
+	}
+	mergedItems := make([]models.FeedItem, len(archived))
+	for i, archivedItem := range archived {
+		mergedItems[i] = archivedItem.Item
+	}
+	return mergedItems, nil
+}
+
+// archiveKey is task cache key with id settings: archived items are deduplicated by id,
+// so items with ids of different strategies must not be mixed. Keys of feeds with default settings are the same as cache keys
+func archiveKey(task models.Task) string {
+	if task.IdStrategy == models.IdStrategy_LinkDate && len(task.IdFields) == 0 {
+		return task.CacheKey()
+	}
+	h := sha256.New()
+	h.Write([]byte(fmt.Sprintf("%d", task.IdStrategy)))
+	for _, field := range task.IdFields {
+		h.Write([]byte{0})
+		h.Write([]byte(field))
+	}
+	return fmt.Sprintf("%s_id%x", task.CacheKey(), h.Sum(nil))
 }
 
 func (h *Handler) handlePageScreenshot(c echo.Context) error {
+	var result models.ScreenshotTaskResult
+	if err := h.runPageTask(c, models.TaskTypePageScreenshot, &result); err != nil {
+		return err
+	}
+	return c.Blob(200, "image/png", result.Image)
+}
+
+// runPageTask runs task of given type for page from url query param and decodes its result
+func (h *Handler) runPageTask(c echo.Context, taskType models.TaskType, result any) error {
 	pageUrl := c.QueryParam("url")
-	if _, err := url.Parse(pageUrl); err != nil {
+	if _, err := url.Parse(pageUrl); err != nil || len(pageUrl) == 0 {
 		return echo.NewHTTPError(400, "url is invalid or missing")
 	}
 
 	task := models.Task{
-		TaskType: models.TaskTypePageScreenshot,
+		TaskType: taskType,
 		URL:      pageUrl,
 		Headers:  extractHeaders(c),
 	}
+	return h.runTask(c, task, result)
+}
 
+// runTask enqueues task without looking into cache and decodes its result
+func (h *Handler) runTask(c echo.Context, task models.Task, result any) error {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), taskTimeout)
 	defer cancel()
 
@@ -180,11 +453,10 @@ func (h *Handler) handlePageScreenshot(c echo.Context) error {
 		return echo.NewHTTPError(500, fmt.Errorf("queued cache failed: %v", err))
 	}
 
-	var result models.ScreenshotTaskResult
-	if err := json.Unmarshal(taskResultBytes, &result); err != nil {
+	if err := json.Unmarshal(taskResultBytes, result); err != nil {
 		return echo.NewHTTPError(500, fmt.Errorf("task result unmarshal failed: %v", err))
 	}
-	return c.Blob(200, "image/png", result.Image)
+	return nil
 }
 
 func (h *Handler) checkRateLimit(c echo.Context) bool {
@@ -246,57 +518,307 @@ func (h *Handler) decodeSpecs(specsParam string) (*pb.Specs, error) {
 	return specs, nil
 }
 
-func makeFeed(task models.Task, result models.TaskResult) (string, error) {
-	feedTS := time.Now()
-	if len(result.Items) > 0 {
-		feedTS = result.Items[0].Created
-	}
-	feed := feeds.Feed{
-		Title:   html.EscapeString(result.Title),
-		Link:    &feeds.Link{Href: task.URL},
-		Updated: feedTS,
+// makeFeed builds feed of task result, resultTS is when result was extracted
+func makeFeed(task models.Task, result models.TaskResult, resultTS time.Time) (*feed, error) {
+	// feed is updated when its latest item was created or updated
+	var feedTS time.Time
+	for _, item := range result.Items {
+		for _, ts := range []time.Time{item.Created, item.Updated} {
+			if ts.After(feedTS) {
+				feedTS = ts
+			}
+		}
 	}
+	if feedTS.IsZero() {
+		// not now, otherwise feed and its etag change on every request
+		feedTS = resultTS
+	}
+	title := cmp.Or(task.FeedTitle, result.Title)
+	subtitle := cmp.Or(task.FeedSubtitle, result.Description)
+	f := feed{
+		Feed: &feeds.Feed{
+			Title:       html.EscapeString(title),
+			Link:        &feeds.Link{Href: task.URL},
+			Description: html.EscapeString(subtitle),
+			Updated:     feedTS,
+		},
+		icon:            result.Icon,
+		logo:            result.Image,
+		language:        result.Language,
+		htmlDescription: isHtmlDescription(task),
+	}
+	seenIds := make(map[string]struct{}, len(result.Items))
 	for _, item := range result.Items {
-		itemUrl, err := url.Parse(item.Link)
+		id, err := makeItemId(task, item)
 		if err != nil {
 			log.Errorf("Invalid item link, item=%+v", item)
 			continue
 		}
-		id := fmt.Sprintf(
-			"tag:%s,%s:%s",
-			itemUrl.Host,
-			anyTimeFormat("2006-01-02", item.Created, item.Updated),
-			itemUrl.Path,
-		)
-		if len(itemUrl.RawQuery) > 0 {
-			id += "?" + itemUrl.RawQuery
+		if _, ok := seenIds[id]; ok {
+			log.Debugf("Duplicate item id=%s, skip", id)
+			continue
+		}
+		seenIds[id] = struct{}{}
+		description := item.Description
+		if !f.htmlDescription {
+			description = html.EscapeString(description)
 		}
-		feed.Items = append(feed.Items, &feeds.Item{
+		f.Items = append(f.Items, &feeds.Item{
 			Id:          id,
 			Title:       html.EscapeString(item.Title),
 			Link:        &feeds.Link{Href: item.Link},
 			Author:      &feeds.Author{Name: item.AuthorName},
-			Description: item.Description,
+			Description: description,
 			Created:     item.Created,
 			Updated:     item.Updated,
 			Content:     item.Content,
+			Enclosure:   makeEnclosure(item),
 		})
+		f.items = append(f.items, item)
 	}
-	if len(feed.Items) == 0 {
-		return "", fmt.Errorf("empty feed")
+	// feed may be empty because of filters, but not because of invalid items
+	if len(f.Items) == 0 && len(result.Items) > 0 {
+		return nil, fmt.Errorf("empty feed")
 	}
-	atomFeed := (&feeds.Atom{Feed: &feed}).AtomFeed()
-	atomFeed.Icon = result.Icon
-	for i, entry := range atomFeed.Entries {
-		if entry.Author != nil {
-			entry.Author.Uri = result.Items[i].AuthorLink
+	return &f, nil
+}
+
+// sanitizeItems cleans item html in place. Fields which failed to sanitize are escaped.
+// Description is html only if it was extracted as html, plain text is escaped by makeFeed
+func (h *Handler) sanitizeItems(task models.Task, items []models.FeedItem) {
+	for i := range items {
+		fields := []*string{&items[i].Content}
+		if isHtmlDescription(task) {
+			fields = append(fields, &items[i].Description)
+		}
+		for _, field := range fields {
+			if len(*field) == 0 {
+				continue
+			}
+			sanitized, err := h.sanitizer.Sanitize(*field, task.URL)
+			if err != nil {
+				log.Errorf("sanitize item %s: %v", items[i].Link, err)
+				sanitized = html.EscapeString(*field)
+			}
+			*field = sanitized
 		}
 	}
-	atom, err := feeds.ToXML(atomFeed)
-	if err != nil {
-		return "", fmt.Errorf("feed to xml: %w", err)
+}
+
+func isHtmlDescription(task models.Task) bool {
+	return task.DescriptionExtractFrom == models.ExtractFrom_InnerHtml
+}
+
+// makeETag is strong etag of response body
+func makeETag(rendered string) string {
+	h := sha256.New()
+	h.Write([]byte(rendered))
+	return fmt.Sprintf(`"%x"`, h.Sum(nil)[:16])
+}
+
+// isNotModified checks conditional request headers; If-None-Match takes precedence (rfc9110 13.2.2)
+func isNotModified(req *http.Request, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := req.Header.Get("If-None-Match"); len(ifNoneMatch) > 0 {
+		for _, reqTag := range strings.Split(ifNoneMatch, ",") {
+			reqTag = strings.TrimPrefix(strings.TrimSpace(reqTag), "W/")
+			if reqTag == "*" || reqTag == etag {
+				return true
+			}
+		}
+		return false
+	}
+	if ifModifiedSince := req.Header.Get(echo.HeaderIfModifiedSince); len(ifModifiedSince) > 0 {
+		ts, err := http.ParseTime(ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		// http dates have second precision
+		return !lastModified.Truncate(time.Second).After(ts)
+	}
+	return false
+}
+
+// convertSelectors rewrites spec selectors to playwright dialect in place
+func convertSelectors(specs *pb.Specs) error {
+	for _, field := range []struct {
+		name     string
+		selector *string
+		dialect  pb.SelectorDialect
+	}{
+		{"post", &specs.SelectorPost, specs.SelectorPostDialect},
+		{"title", &specs.SelectorTitle, specs.SelectorTitleDialect},
+		{"link", &specs.SelectorLink, specs.SelectorLinkDialect},
+		{"description", &specs.SelectorDescription, specs.SelectorDescriptionDialect},
+		{"author", &specs.SelectorAuthor, specs.SelectorAuthorDialect},
+		{"created", &specs.SelectorCreated, specs.SelectorCreatedDialect},
+		{"content", &specs.SelectorContent, specs.SelectorContentDialect},
+		{"enclosure", &specs.SelectorEnclosure, specs.SelectorEnclosureDialect},
+		{"id", &specs.SelectorId, specs.SelectorIdDialect},
+		{"fulltext", &specs.SelectorFulltext, specs.SelectorFulltextDialect},
+		{"next page", &specs.SelectorNextPage, specs.SelectorNextPageDialect},
+		{"load more", &specs.SelectorLoadMore, specs.SelectorLoadMoreDialect},
+		{"updated", &specs.SelectorUpdated, specs.SelectorUpdatedDialect},
+	} {
+		var err error
+		*field.selector, err = playwrightSelector(*field.selector, field.dialect)
+		if err != nil {
+			return fmt.Errorf("selector %s: %w", field.name, err)
+		}
+	}
+	return nil
+}
+
+// playwrightSelector converts selector of given dialect to playwright selector.
+// Css selectors are valid playwright selectors, so they are kept as is, and cache keys don't change
+func playwrightSelector(selector string, dialect pb.SelectorDialect) (string, error) {
+	if len(selector) == 0 {
+		return "", nil
+	}
+	switch dialect {
+	case pb.SelectorDialect_Css, pb.SelectorDialect_Playwright:
+		return selector, nil
+	case pb.SelectorDialect_XPath:
+		return "xpath=" + selector, nil
+	default:
+		return "", fmt.Errorf("invalid selector dialect")
+	}
+}
+
+func makeExtractFrom(from pb.ExtractFrom) (models.ExtractFrom, error) {
+	extractFrom, ok := map[pb.ExtractFrom]models.ExtractFrom{
+		pb.ExtractFrom_InnerText:   models.ExtractFrom_InnerText,
+		pb.ExtractFrom_Attribute:   models.ExtractFrom_Attribute,
+		pb.ExtractFrom_InnerHtml:   models.ExtractFrom_InnerHtml,
+		pb.ExtractFrom_TextContent: models.ExtractFrom_TextContent,
+	}[from]
+	if !ok {
+		return 0, fmt.Errorf("invalid extract from")
+	}
+	return extractFrom, nil
+}
+
+// makeActions converts spec actions and checks fields required by action type
+func makeActions(specActions []*pb.Action) ([]models.Action, error) {
+	var actions []models.Action
+	var totalTime time.Duration
+	for i, specAction := range specActions {
+		actionType, ok := map[pb.ActionType]models.ActionType{
+			pb.ActionType_Click:           models.ActionType_Click,
+			pb.ActionType_Fill:            models.ActionType_Fill,
+			pb.ActionType_Press:           models.ActionType_Press,
+			pb.ActionType_WaitForSelector: models.ActionType_WaitForSelector,
+			pb.ActionType_WaitForTimeout:  models.ActionType_WaitForTimeout,
+			pb.ActionType_SelectOption:    models.ActionType_SelectOption,
+		}[specAction.Type]
+		if !ok {
+			return nil, fmt.Errorf("action %d: invalid type", i+1)
+		}
+		selector, err := playwrightSelector(specAction.Selector, specAction.SelectorDialect)
+		if err != nil {
+			return nil, fmt.Errorf("action %d: %w", i+1, err)
+		}
+		action := models.Action{
+			Type:     actionType,
+			Selector: selector,
+			Value:    specAction.Value,
+			Timeout:  time.Duration(specAction.TimeoutMs) * time.Millisecond,
+			Optional: specAction.Optional,
+		}
+		switch action.Type {
+		case models.ActionType_Click, models.ActionType_Fill, models.ActionType_WaitForSelector:
+			if len(action.Selector) == 0 {
+				return nil, fmt.Errorf("action %d (%s): selector required", i+1, action.Type)
+			}
+		case models.ActionType_Press:
+			if len(action.Value) == 0 {
+				return nil, fmt.Errorf("action %d (%s): key required", i+1, action.Type)
+			}
+		case models.ActionType_SelectOption:
+			if len(action.Selector) == 0 || len(action.Value) == 0 {
+				return nil, fmt.Errorf("action %d (%s): selector and value required", i+1, action.Type)
+			}
+		case models.ActionType_WaitForTimeout:
+			if action.Timeout == 0 {
+				return nil, fmt.Errorf("action %d (%s): timeout required", i+1, action.Type)
+			}
+		}
+		totalTime += action.MaxTime()
+		if totalTime > models.MaxActionsTime {
+			return nil, fmt.Errorf(
+				"actions can take up to %v in total, including %v for each action without timeout",
+				models.MaxActionsTime, models.DefaultActionTimeout,
+			)
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// makeJsonMapping converts json source fields of spec and checks that queries compile
+func makeJsonMapping(specs *pb.Specs) (models.JsonMapping, error) {
+	language, ok := map[pb.JsonQueryLanguage]models.JsonQueryLanguage{
+		pb.JsonQueryLanguage_JsonPath: models.JsonQueryLanguage_JsonPath,
+		pb.JsonQueryLanguage_JmesPath: models.JsonQueryLanguage_JmesPath,
+	}[specs.JsonQueryLanguage]
+	if !ok {
+		return models.JsonMapping{}, fmt.Errorf("invalid json query language")
+	}
+	method, ok := map[pb.HttpMethod]string{
+		pb.HttpMethod_Get:  http.MethodGet,
+		pb.HttpMethod_Post: http.MethodPost,
+	}[specs.JsonMethod]
+	if !ok {
+		return models.JsonMapping{}, fmt.Errorf("invalid json method")
+	}
+	mapping := models.JsonMapping{
+		Method:      method,
+		Language:    language,
+		Items:       specs.JsonItems,
+		Title:       specs.JsonTitle,
+		Link:        specs.JsonLink,
+		Description: specs.JsonDescription,
+		Content:     specs.JsonContent,
+		Author:      specs.JsonAuthor,
+		Created:     specs.JsonCreated,
+		Enclosure:   specs.JsonEnclosure,
+	}
+	if method == http.MethodPost {
+		mapping.Body = specs.JsonBody
+	}
+	if _, err := jsonquery.CompileMapping(mapping); err != nil {
+		return models.JsonMapping{}, fmt.Errorf("json mapping: %w", err)
+	}
+	return mapping, nil
+}
+
+// makeTransforms converts spec transforms and checks that they compile
+func makeTransforms(specTransforms []*pb.Transform) ([]models.Transform, error) {
+	var transforms []models.Transform
+	for i, specTransform := range specTransforms {
+		transformType, ok := map[pb.TransformType]models.TransformType{
+			pb.TransformType_RegexExtract: models.TransformType_RegexExtract,
+			pb.TransformType_RegexReplace: models.TransformType_RegexReplace,
+			pb.TransformType_Trim:         models.TransformType_Trim,
+			pb.TransformType_Lowercase:    models.TransformType_Lowercase,
+			pb.TransformType_Uppercase:    models.TransformType_Uppercase,
+			pb.TransformType_Prefix:       models.TransformType_Prefix,
+			pb.TransformType_Suffix:       models.TransformType_Suffix,
+			pb.TransformType_Template:     models.TransformType_Template,
+		}[specTransform.Type]
+		if !ok {
+			return nil, fmt.Errorf("transform %d: invalid type", i+1)
+		}
+		transforms = append(transforms, models.Transform{
+			Field:   specTransform.Field,
+			Type:    transformType,
+			Pattern: specTransform.Pattern,
+			Value:   specTransform.Value,
+		})
+	}
+	if _, err := transform.Compile(transforms); err != nil {
+		return nil, err
 	}
-	return atom, nil
+	return transforms, nil
 }
 
 func extractHeaders(c echo.Context) map[string]string {
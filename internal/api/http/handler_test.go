@@ -0,0 +1,199 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/egor3f/rssalchemy/internal/api/http/pb"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/egor3f/rssalchemy/internal/sanitizer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestMakeETag(t *testing.T) {
+	assert.Equal(t, makeETag("<feed></feed>"), makeETag("<feed></feed>"))
+	assert.NotEqual(t, makeETag("<feed></feed>"), makeETag("<rss></rss>"))
+}
+
+func TestIsNotModified(t *testing.T) {
+	etag := `"abc"`
+	lastModified := time.Date(2025, 1, 10, 10, 0, 0, 500, time.UTC)
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected bool
+	}{
+		{"no conditions", nil, false},
+		{"etag match", map[string]string{"If-None-Match": `"abc"`}, true},
+		{"weak etag match", map[string]string{"If-None-Match": `W/"abc"`}, true},
+		{"etag in list", map[string]string{"If-None-Match": `"def", "abc"`}, true},
+		{"etag star", map[string]string{"If-None-Match": `*`}, true},
+		{"etag mismatch", map[string]string{"If-None-Match": `"def"`}, false},
+		{
+			"etag takes precedence",
+			map[string]string{"If-None-Match": `"def"`, "If-Modified-Since": "Fri, 10 Jan 2025 10:00:00 GMT"},
+			false,
+		},
+		{"not modified since", map[string]string{"If-Modified-Since": "Fri, 10 Jan 2025 10:00:00 GMT"}, true},
+		{"modified since", map[string]string{"If-Modified-Since": "Fri, 10 Jan 2025 09:59:59 GMT"}, false},
+		{"invalid date", map[string]string{"If-Modified-Since": "yesterday"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			assert.Equal(t, tt.expected, isNotModified(req, etag, lastModified))
+		})
+	}
+}
+
+func TestMakeActions(t *testing.T) {
+	actions, err := makeActions([]*pb.Action{
+		{Type: pb.ActionType_Click, Selector: "#accept", Optional: true},
+		{Type: pb.ActionType_Fill, Selector: "input[name=q]", Value: "news", TimeoutMs: 1000},
+		{Type: pb.ActionType_Press, Value: "Enter"},
+		{Type: pb.ActionType_WaitForTimeout, TimeoutMs: 500},
+	})
+	require.NoError(t, err)
+	require.Len(t, actions, 4)
+	assert.Equal(t, models.Action{Type: models.ActionType_Click, Selector: "#accept", Optional: true}, actions[0])
+	assert.Equal(t, time.Second, actions[1].Timeout)
+
+	tests := []struct {
+		name   string
+		action *pb.Action
+	}{
+		{"click without selector", &pb.Action{Type: pb.ActionType_Click}},
+		{"press without key", &pb.Action{Type: pb.ActionType_Press, Selector: "input"}},
+		{"select without value", &pb.Action{Type: pb.ActionType_SelectOption, Selector: "select"}},
+		{"wait without timeout", &pb.Action{Type: pb.ActionType_WaitForTimeout}},
+		{"invalid type", &pb.Action{Type: 100, Selector: "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := makeActions([]*pb.Action{{Type: pb.ActionType_WaitForSelector, Selector: "a"}, tt.action})
+			assert.ErrorContains(t, err, "action 2")
+		})
+	}
+
+	_, err = makeActions([]*pb.Action{
+		{Type: pb.ActionType_WaitForTimeout, TimeoutMs: 15000},
+		{Type: pb.ActionType_Click, Selector: "#more"},
+		{Type: pb.ActionType_Click, Selector: "#more"},
+	})
+	assert.ErrorContains(t, err, "in total")
+}
+
+func TestConvertSelectors(t *testing.T) {
+	specs := &pb.Specs{
+		SelectorPost:         "article",
+		SelectorTitle:        "h2/following-sibling::p",
+		SelectorTitleDialect: pb.SelectorDialect_XPath,
+		SelectorLink:         "a:has-text('Read')",
+		SelectorLinkDialect:  pb.SelectorDialect_Playwright,
+		SelectorIdDialect:    pb.SelectorDialect_XPath,
+	}
+	require.NoError(t, convertSelectors(specs))
+	assert.Equal(t, "article", specs.SelectorPost)
+	assert.Equal(t, "xpath=h2/following-sibling::p", specs.SelectorTitle)
+	assert.Equal(t, "a:has-text('Read')", specs.SelectorLink)
+	assert.Empty(t, specs.SelectorId, "empty selector stays empty")
+
+	assert.Error(t, convertSelectors(&pb.Specs{SelectorPost: "a", SelectorPostDialect: 100}))
+}
+
+func TestMakeJsonMapping(t *testing.T) {
+	specs := &pb.Specs{
+		SourceType:        pb.SourceType_JsonApi,
+		JsonMethod:        pb.HttpMethod_Post,
+		JsonBody:          `{"page": 1}`,
+		JsonQueryLanguage: pb.JsonQueryLanguage_JmesPath,
+		JsonItems:         "data.posts",
+		JsonTitle:         "title",
+		JsonLink:          "url",
+	}
+	mapping, err := makeJsonMapping(specs)
+	require.NoError(t, err)
+	assert.Equal(t, "POST", mapping.Method)
+	assert.Equal(t, `{"page": 1}`, mapping.Body)
+	assert.Equal(t, models.JsonQueryLanguage_JmesPath, mapping.Language)
+
+	specs.JsonMethod = pb.HttpMethod_Get
+	mapping, err = makeJsonMapping(specs)
+	require.NoError(t, err)
+	assert.Empty(t, mapping.Body, "body is sent only with post")
+
+	specs.JsonTitle = "title[?"
+	_, err = makeJsonMapping(specs)
+	assert.Error(t, err)
+}
+
+func TestArchiveKey(t *testing.T) {
+	task := models.Task{TaskType: models.TaskTypeExtract, URL: "https://example.com"}
+	assert.Equal(t, task.CacheKey(), archiveKey(task), "keys of existing archives are kept")
+
+	hashTask := task
+	hashTask.IdStrategy = models.IdStrategy_LinkHash
+	fieldsTask := task
+	fieldsTask.IdStrategy = models.IdStrategy_FieldsHash
+	fieldsTask.IdFields = []string{"title"}
+	otherFieldsTask := fieldsTask
+	otherFieldsTask.IdFields = []string{"title", "link"}
+
+	keys := []string{archiveKey(task), archiveKey(hashTask), archiveKey(fieldsTask), archiveKey(otherFieldsTask)}
+	for i := range keys {
+		for j := i + 1; j < len(keys); j++ {
+			assert.NotEqual(t, keys[i], keys[j])
+		}
+	}
+	assert.Regexp(t, `^[-/_=.a-zA-Z0-9]+$`, archiveKey(fieldsTask), "valid nats key")
+}
+
+func TestSpecsValidation(t *testing.T) {
+	h := New(
+		testQueue{t},
+		&testCache{},
+		&testArchive{},
+		sanitizer.New(sanitizer.DefaultPolicy()),
+		rate.Inf,
+		1,
+		false,
+	)
+	valid := func() *pb.Specs {
+		return &pb.Specs{
+			Url:           "https://example.com/blog",
+			SelectorPost:  "article",
+			SelectorTitle: "h2",
+			SelectorLink:  "a",
+			CacheLifetime: "1h",
+		}
+	}
+	require.NoError(t, h.validate.Struct(valid()))
+
+	tests := []struct {
+		name   string
+		modify func(specs *pb.Specs)
+	}{
+		{"created attribute without name", func(specs *pb.Specs) {
+			specs.SelectorCreated = "time"
+			specs.CreatedExtractFrom = pb.ExtractFrom_Attribute
+		}},
+		{"updated attribute without name", func(specs *pb.Specs) {
+			specs.SelectorUpdated = "time"
+			specs.UpdatedExtractFrom = pb.ExtractFrom_Attribute
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			specs := valid()
+			tt.modify(specs)
+			assert.Error(t, h.validate.Struct(specs))
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package http
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Query params used only for tracking, they are removed from links before hashing
+var trackingParams = []string{
+	"fbclid", "gclid", "dclid", "msclkid", "yclid", "mc_cid", "mc_eid", "_openstat", "igshid", "ref_src",
+}
+
+// uuidNamespaceUrl is the RFC 4122 namespace for names that are URLs
+var uuidNamespaceUrl = [16]byte{
+	0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8,
+}
+
+// makeItemId generates item id according to task.IdStrategy, the same for all feed formats
+func makeItemId(task models.Task, item models.FeedItem) (string, error) {
+	switch task.IdStrategy {
+	case models.IdStrategy_LinkDate:
+		return linkDateId(item)
+	case models.IdStrategy_Selector:
+		if len(item.Id) > 0 {
+			// extracted ids are unique only within a site
+			return uuidUrn(task.URL + "\x00" + item.Id), nil
+		}
+		fallthrough // element without id
+	case models.IdStrategy_LinkHash:
+		link, err := canonicalLink(item.Link)
+		if err != nil {
+			return "", fmt.Errorf("canonical link: %w", err)
+		}
+		return uuidUrn(link), nil
+	case models.IdStrategy_FieldsHash:
+		values := []string{task.URL}
+		for _, field := range task.IdFields {
+			value, err := itemField(item, field)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, value)
+		}
+		return uuidUrn(strings.Join(values, "\x00")), nil
+	default:
+		return "", fmt.Errorf("unknown id strategy: %d", task.IdStrategy)
+	}
+}
+
+// linkDateId is legacy id, it changes if date parser returns another day
+func linkDateId(item models.FeedItem) (string, error) {
+	itemUrl, err := url.Parse(item.Link)
+	if err != nil {
+		return "", fmt.Errorf("parse item link: %w", err)
+	}
+	id := fmt.Sprintf(
+		"tag:%s,%s:%s",
+		itemUrl.Host,
+		anyTimeFormat("2006-01-02", item.Created, item.Updated),
+		itemUrl.Path,
+	)
+	if len(itemUrl.RawQuery) > 0 {
+		id += "?" + itemUrl.RawQuery
+	}
+	return id, nil
+}
+
+func itemField(item models.FeedItem, field string) (string, error) {
+	switch field {
+	case "title":
+		return item.Title, nil
+	case "link":
+		return canonicalLink(item.Link)
+	case "description":
+		return item.Description, nil
+	case "author":
+		return item.AuthorName, nil
+	case "content":
+		return item.Content, nil
+	case "created":
+		return anyTimeFormat("2006-01-02", item.Created), nil
+	default:
+		return "", fmt.Errorf("unknown id field: %s", field)
+	}
+}
+
+// canonicalLink removes fragment and tracking query params, sorts other params
+func canonicalLink(link string) (string, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("parse link: %w", err)
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	u.RawFragment = ""
+	query := u.Query()
+	for param := range query {
+		if strings.HasPrefix(strings.ToLower(param), "utm_") || slices.Contains(trackingParams, strings.ToLower(param)) {
+			query.Del(param)
+		}
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// uuidUrn returns name-based (version 5) uuid urn
+func uuidUrn(name string) string {
+	h := sha1.New()
+	h.Write(uuidNamespaceUrl[:])
+	h.Write([]byte(name))
+	u := h.Sum(nil)[:16]
+	u[6] = (u[6] & 0x0f) | 0x50 // version 5
+	u[8] = (u[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16])
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalLink(t *testing.T) {
+	tests := []struct {
+		link     string
+		expected string
+	}{
+		{"https://example.com/post", "https://example.com/post"},
+		{"HTTPS://Example.COM/Post", "https://example.com/Post"},
+		{"https://example.com/post#comments", "https://example.com/post"},
+		{"https://example.com/post?utm_source=tg&UTM_medium=x", "https://example.com/post"},
+		{"https://example.com/post?fbclid=1&id=5", "https://example.com/post?id=5"},
+		{"https://example.com/post?b=2&a=1", "https://example.com/post?a=1&b=2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.link, func(t *testing.T) {
+			link, err := canonicalLink(tt.link)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, link)
+		})
+	}
+}
+
+func TestUuidUrn(t *testing.T) {
+	id := uuidUrn("https://example.com/post")
+	assert.Equal(t, "urn:uuid:026d694d-fb00-50d6-a348-4051b8ece183", id)
+	assert.NotEqual(t, id, uuidUrn("https://example.com/other"))
+}
+
+func TestMakeItemId(t *testing.T) {
+	item := models.FeedItem{
+		Title:   "Post",
+		Link:    "https://example.com/post?utm_source=x#top",
+		Created: time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC),
+	}
+	nextDay := item
+	nextDay.Created = item.Created.Add(24 * time.Hour)
+	nextDay.Link = "https://example.com/post"
+
+	tests := []struct {
+		name     string
+		task     models.Task
+		sameNext bool // id stays the same when date and tracking params change
+	}{
+		{"link date", models.Task{IdStrategy: models.IdStrategy_LinkDate}, false},
+		{"link hash", models.Task{IdStrategy: models.IdStrategy_LinkHash}, true},
+		{"selector without id", models.Task{IdStrategy: models.IdStrategy_Selector}, true},
+		{
+			"fields hash",
+			models.Task{IdStrategy: models.IdStrategy_FieldsHash, IdFields: []string{"title", "link"}},
+			true,
+		},
+		{
+			"fields hash with date",
+			models.Task{IdStrategy: models.IdStrategy_FieldsHash, IdFields: []string{"link", "created"}},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := makeItemId(tt.task, item)
+			require.NoError(t, err)
+			nextId, err := makeItemId(tt.task, nextDay)
+			require.NoError(t, err)
+			assert.Equal(t, tt.sameNext, id == nextId)
+		})
+	}
+
+	t.Run("selector", func(t *testing.T) {
+		task := models.Task{URL: "https://example.com", IdStrategy: models.IdStrategy_Selector}
+		withId := item
+		withId.Id = "42"
+		id, err := makeItemId(task, withId)
+		require.NoError(t, err)
+		withId.Link = "https://example.com/renamed"
+		renamedId, err := makeItemId(task, withId)
+		require.NoError(t, err)
+		assert.Equal(t, id, renamedId)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		task := models.Task{IdStrategy: models.IdStrategy_FieldsHash, IdFields: []string{"foo"}}
+		_, err := makeItemId(task, item)
+		assert.Error(t, err)
+	})
+}
+
+func TestMakeFeedDuplicateIds(t *testing.T) {
+	task := testTask
+	task.IdStrategy = models.IdStrategy_LinkHash
+	result := testResult
+	duplicate := result.Items[0]
+	duplicate.Title = "Pinned copy"
+	duplicate.Link += "&utm_source=pinned"
+	result.Items = []models.FeedItem{result.Items[0], duplicate}
+
+	f, err := makeFeed(task, result, time.Now())
+	require.NoError(t, err)
+	require.Len(t, f.Items, 1)
+	assert.Equal(t, "First post", f.Items[0].Title)
+}
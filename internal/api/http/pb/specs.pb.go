@@ -25,8 +25,10 @@ const (
 type ExtractFrom int32
 
 const (
-	ExtractFrom_InnerText ExtractFrom = 0
-	ExtractFrom_Attribute ExtractFrom = 1
+	ExtractFrom_InnerText   ExtractFrom = 0
+	ExtractFrom_Attribute   ExtractFrom = 1
+	ExtractFrom_InnerHtml   ExtractFrom = 2
+	ExtractFrom_TextContent ExtractFrom = 3
 )
 
 // Enum value maps for ExtractFrom.
@@ -34,10 +36,14 @@ var (
 	ExtractFrom_name = map[int32]string{
 		0: "InnerText",
 		1: "Attribute",
+		2: "InnerHtml",
+		3: "TextContent",
 	}
 	ExtractFrom_value = map[string]int32{
-		"InnerText": 0,
-		"Attribute": 1,
+		"InnerText":   0,
+		"Attribute":   1,
+		"InnerHtml":   2,
+		"TextContent": 3,
 	}
 )
 
@@ -68,218 +74,2175 @@ func (ExtractFrom) EnumDescriptor() ([]byte, []int) {
 	return file_proto_specs_proto_rawDescGZIP(), []int{0}
 }
 
+type SelectorDialect int32
+
+const (
+	SelectorDialect_Css        SelectorDialect = 0
+	SelectorDialect_XPath      SelectorDialect = 1
+	SelectorDialect_Playwright SelectorDialect = 2
+)
+
+// Enum value maps for SelectorDialect.
+var (
+	SelectorDialect_name = map[int32]string{
+		0: "Css",
+		1: "XPath",
+		2: "Playwright",
+	}
+	SelectorDialect_value = map[string]int32{
+		"Css":        0,
+		"XPath":      1,
+		"Playwright": 2,
+	}
+)
+
+func (x SelectorDialect) Enum() *SelectorDialect {
+	p := new(SelectorDialect)
+	*p = x
+	return p
+}
+
+func (x SelectorDialect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SelectorDialect) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[1].Descriptor()
+}
+
+func (SelectorDialect) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[1]
+}
+
+func (x SelectorDialect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SelectorDialect.Descriptor instead.
+func (SelectorDialect) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{1}
+}
+
+type FeedFormat int32
+
+const (
+	FeedFormat_Atom     FeedFormat = 0
+	FeedFormat_Rss      FeedFormat = 1
+	FeedFormat_JsonFeed FeedFormat = 2
+)
+
+// Enum value maps for FeedFormat.
+var (
+	FeedFormat_name = map[int32]string{
+		0: "Atom",
+		1: "Rss",
+		2: "JsonFeed",
+	}
+	FeedFormat_value = map[string]int32{
+		"Atom":     0,
+		"Rss":      1,
+		"JsonFeed": 2,
+	}
+)
+
+func (x FeedFormat) Enum() *FeedFormat {
+	p := new(FeedFormat)
+	*p = x
+	return p
+}
+
+func (x FeedFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[2].Descriptor()
+}
+
+func (FeedFormat) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[2]
+}
+
+func (x FeedFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedFormat.Descriptor instead.
+func (FeedFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{2}
+}
+
+type IdStrategy int32
+
+const (
+	IdStrategy_LinkDate   IdStrategy = 0
+	IdStrategy_LinkHash   IdStrategy = 1
+	IdStrategy_Selector   IdStrategy = 2
+	IdStrategy_FieldsHash IdStrategy = 3
+)
+
+// Enum value maps for IdStrategy.
+var (
+	IdStrategy_name = map[int32]string{
+		0: "LinkDate",
+		1: "LinkHash",
+		2: "Selector",
+		3: "FieldsHash",
+	}
+	IdStrategy_value = map[string]int32{
+		"LinkDate":   0,
+		"LinkHash":   1,
+		"Selector":   2,
+		"FieldsHash": 3,
+	}
+)
+
+func (x IdStrategy) Enum() *IdStrategy {
+	p := new(IdStrategy)
+	*p = x
+	return p
+}
+
+func (x IdStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[3].Descriptor()
+}
+
+func (IdStrategy) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[3]
+}
+
+func (x IdStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdStrategy.Descriptor instead.
+func (IdStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{3}
+}
+
+type FullTextMode int32
+
+const (
+	FullTextMode_Off        FullTextMode = 0
+	FullTextMode_BySelector FullTextMode = 1
+	FullTextMode_Auto       FullTextMode = 2
+)
+
+// Enum value maps for FullTextMode.
+var (
+	FullTextMode_name = map[int32]string{
+		0: "Off",
+		1: "BySelector",
+		2: "Auto",
+	}
+	FullTextMode_value = map[string]int32{
+		"Off":        0,
+		"BySelector": 1,
+		"Auto":       2,
+	}
+)
+
+func (x FullTextMode) Enum() *FullTextMode {
+	p := new(FullTextMode)
+	*p = x
+	return p
+}
+
+func (x FullTextMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FullTextMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[4].Descriptor()
+}
+
+func (FullTextMode) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[4]
+}
+
+func (x FullTextMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FullTextMode.Descriptor instead.
+func (FullTextMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{4}
+}
+
+type RenderMode int32
+
+const (
+	RenderMode_Browser         RenderMode = 0
+	RenderMode_Static          RenderMode = 1
+	RenderMode_StaticOrBrowser RenderMode = 2
+)
+
+// Enum value maps for RenderMode.
+var (
+	RenderMode_name = map[int32]string{
+		0: "Browser",
+		1: "Static",
+		2: "StaticOrBrowser",
+	}
+	RenderMode_value = map[string]int32{
+		"Browser":         0,
+		"Static":          1,
+		"StaticOrBrowser": 2,
+	}
+)
+
+func (x RenderMode) Enum() *RenderMode {
+	p := new(RenderMode)
+	*p = x
+	return p
+}
+
+func (x RenderMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RenderMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[5].Descriptor()
+}
+
+func (RenderMode) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[5]
+}
+
+func (x RenderMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RenderMode.Descriptor instead.
+func (RenderMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{5}
+}
+
+type SourceType int32
+
+const (
+	SourceType_Page    SourceType = 0
+	SourceType_JsonApi SourceType = 1
+)
+
+// Enum value maps for SourceType.
+var (
+	SourceType_name = map[int32]string{
+		0: "Page",
+		1: "JsonApi",
+	}
+	SourceType_value = map[string]int32{
+		"Page":    0,
+		"JsonApi": 1,
+	}
+)
+
+func (x SourceType) Enum() *SourceType {
+	p := new(SourceType)
+	*p = x
+	return p
+}
+
+func (x SourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[6].Descriptor()
+}
+
+func (SourceType) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[6]
+}
+
+func (x SourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SourceType.Descriptor instead.
+func (SourceType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{6}
+}
+
+type StructuredDataMode int32
+
+const (
+	StructuredDataMode_StructuredDataOff      StructuredDataMode = 0
+	StructuredDataMode_StructuredDataFallback StructuredDataMode = 1
+	StructuredDataMode_StructuredDataOnly     StructuredDataMode = 2
+)
+
+// Enum value maps for StructuredDataMode.
+var (
+	StructuredDataMode_name = map[int32]string{
+		0: "StructuredDataOff",
+		1: "StructuredDataFallback",
+		2: "StructuredDataOnly",
+	}
+	StructuredDataMode_value = map[string]int32{
+		"StructuredDataOff":      0,
+		"StructuredDataFallback": 1,
+		"StructuredDataOnly":     2,
+	}
+)
+
+func (x StructuredDataMode) Enum() *StructuredDataMode {
+	p := new(StructuredDataMode)
+	*p = x
+	return p
+}
+
+func (x StructuredDataMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StructuredDataMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[7].Descriptor()
+}
+
+func (StructuredDataMode) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[7]
+}
+
+func (x StructuredDataMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StructuredDataMode.Descriptor instead.
+func (StructuredDataMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{7}
+}
+
+type JsonQueryLanguage int32
+
+const (
+	JsonQueryLanguage_JsonPath JsonQueryLanguage = 0
+	JsonQueryLanguage_JmesPath JsonQueryLanguage = 1
+)
+
+// Enum value maps for JsonQueryLanguage.
+var (
+	JsonQueryLanguage_name = map[int32]string{
+		0: "JsonPath",
+		1: "JmesPath",
+	}
+	JsonQueryLanguage_value = map[string]int32{
+		"JsonPath": 0,
+		"JmesPath": 1,
+	}
+)
+
+func (x JsonQueryLanguage) Enum() *JsonQueryLanguage {
+	p := new(JsonQueryLanguage)
+	*p = x
+	return p
+}
+
+func (x JsonQueryLanguage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JsonQueryLanguage) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[8].Descriptor()
+}
+
+func (JsonQueryLanguage) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[8]
+}
+
+func (x JsonQueryLanguage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JsonQueryLanguage.Descriptor instead.
+func (JsonQueryLanguage) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{8}
+}
+
+type HttpMethod int32
+
+const (
+	HttpMethod_Get  HttpMethod = 0
+	HttpMethod_Post HttpMethod = 1
+)
+
+// Enum value maps for HttpMethod.
+var (
+	HttpMethod_name = map[int32]string{
+		0: "Get",
+		1: "Post",
+	}
+	HttpMethod_value = map[string]int32{
+		"Get":  0,
+		"Post": 1,
+	}
+)
+
+func (x HttpMethod) Enum() *HttpMethod {
+	p := new(HttpMethod)
+	*p = x
+	return p
+}
+
+func (x HttpMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HttpMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[9].Descriptor()
+}
+
+func (HttpMethod) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[9]
+}
+
+func (x HttpMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HttpMethod.Descriptor instead.
+func (HttpMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{9}
+}
+
+type ActionType int32
+
+const (
+	ActionType_Click           ActionType = 0
+	ActionType_Fill            ActionType = 1
+	ActionType_Press           ActionType = 2
+	ActionType_WaitForSelector ActionType = 3
+	ActionType_WaitForTimeout  ActionType = 4
+	ActionType_SelectOption    ActionType = 5
+)
+
+// Enum value maps for ActionType.
+var (
+	ActionType_name = map[int32]string{
+		0: "Click",
+		1: "Fill",
+		2: "Press",
+		3: "WaitForSelector",
+		4: "WaitForTimeout",
+		5: "SelectOption",
+	}
+	ActionType_value = map[string]int32{
+		"Click":           0,
+		"Fill":            1,
+		"Press":           2,
+		"WaitForSelector": 3,
+		"WaitForTimeout":  4,
+		"SelectOption":    5,
+	}
+)
+
+func (x ActionType) Enum() *ActionType {
+	p := new(ActionType)
+	*p = x
+	return p
+}
+
+func (x ActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[10].Descriptor()
+}
+
+func (ActionType) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[10]
+}
+
+func (x ActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionType.Descriptor instead.
+func (ActionType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{10}
+}
+
+type FilterField int32
+
+const (
+	FilterField_Title       FilterField = 0
+	FilterField_Description FilterField = 1
+	FilterField_Content     FilterField = 2
+	FilterField_Author      FilterField = 3
+	FilterField_Link        FilterField = 4
+)
+
+// Enum value maps for FilterField.
+var (
+	FilterField_name = map[int32]string{
+		0: "Title",
+		1: "Description",
+		2: "Content",
+		3: "Author",
+		4: "Link",
+	}
+	FilterField_value = map[string]int32{
+		"Title":       0,
+		"Description": 1,
+		"Content":     2,
+		"Author":      3,
+		"Link":        4,
+	}
+)
+
+func (x FilterField) Enum() *FilterField {
+	p := new(FilterField)
+	*p = x
+	return p
+}
+
+func (x FilterField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[11].Descriptor()
+}
+
+func (FilterField) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[11]
+}
+
+func (x FilterField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterField.Descriptor instead.
+func (FilterField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{11}
+}
+
+type FilterMatch int32
+
+const (
+	FilterMatch_Substring FilterMatch = 0
+	FilterMatch_Regex     FilterMatch = 1
+)
+
+// Enum value maps for FilterMatch.
+var (
+	FilterMatch_name = map[int32]string{
+		0: "Substring",
+		1: "Regex",
+	}
+	FilterMatch_value = map[string]int32{
+		"Substring": 0,
+		"Regex":     1,
+	}
+)
+
+func (x FilterMatch) Enum() *FilterMatch {
+	p := new(FilterMatch)
+	*p = x
+	return p
+}
+
+func (x FilterMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[12].Descriptor()
+}
+
+func (FilterMatch) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[12]
+}
+
+func (x FilterMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterMatch.Descriptor instead.
+func (FilterMatch) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{12}
+}
+
+type FilterMode int32
+
+const (
+	FilterMode_All FilterMode = 0
+	FilterMode_Any FilterMode = 1
+)
+
+// Enum value maps for FilterMode.
+var (
+	FilterMode_name = map[int32]string{
+		0: "All",
+		1: "Any",
+	}
+	FilterMode_value = map[string]int32{
+		"All": 0,
+		"Any": 1,
+	}
+)
+
+func (x FilterMode) Enum() *FilterMode {
+	p := new(FilterMode)
+	*p = x
+	return p
+}
+
+func (x FilterMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[13].Descriptor()
+}
+
+func (FilterMode) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[13]
+}
+
+func (x FilterMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterMode.Descriptor instead.
+func (FilterMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{13}
+}
+
+type TransformType int32
+
+const (
+	TransformType_RegexExtract TransformType = 0
+	TransformType_RegexReplace TransformType = 1
+	TransformType_Trim         TransformType = 2
+	TransformType_Lowercase    TransformType = 3
+	TransformType_Uppercase    TransformType = 4
+	TransformType_Prefix       TransformType = 5
+	TransformType_Suffix       TransformType = 6
+	TransformType_Template     TransformType = 7
+)
+
+// Enum value maps for TransformType.
+var (
+	TransformType_name = map[int32]string{
+		0: "RegexExtract",
+		1: "RegexReplace",
+		2: "Trim",
+		3: "Lowercase",
+		4: "Uppercase",
+		5: "Prefix",
+		6: "Suffix",
+		7: "Template",
+	}
+	TransformType_value = map[string]int32{
+		"RegexExtract": 0,
+		"RegexReplace": 1,
+		"Trim":         2,
+		"Lowercase":    3,
+		"Uppercase":    4,
+		"Prefix":       5,
+		"Suffix":       6,
+		"Template":     7,
+	}
+)
+
+func (x TransformType) Enum() *TransformType {
+	p := new(TransformType)
+	*p = x
+	return p
+}
+
+func (x TransformType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransformType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_specs_proto_enumTypes[14].Descriptor()
+}
+
+func (TransformType) Type() protoreflect.EnumType {
+	return &file_proto_specs_proto_enumTypes[14]
+}
+
+func (x TransformType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransformType.Descriptor instead.
+func (TransformType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{14}
+}
+
+type Action struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Type            ActionType             `protobuf:"varint,1,opt,name=type,proto3,enum=rssalchemy.ActionType" json:"type"`
+	Selector        string                 `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector" validate:"omitempty,selector=SelectorDialect"`
+	Value           string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value"`
+	TimeoutMs       int32                  `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms" validate:"gte=0,lte=30000"`
+	Optional        bool                   `protobuf:"varint,5,opt,name=optional,proto3" json:"optional"`
+	SelectorDialect SelectorDialect        `protobuf:"varint,6,opt,name=selector_dialect,json=selectorDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_dialect"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Action) Reset() {
+	*x = Action{}
+	mi := &file_proto_specs_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action) ProtoMessage() {}
+
+func (x *Action) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_specs_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action.ProtoReflect.Descriptor instead.
+func (*Action) Descriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Action) GetType() ActionType {
+	if x != nil {
+		return x.Type
+	}
+	return ActionType_Click
+}
+
+func (x *Action) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *Action) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Action) GetTimeoutMs() int32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *Action) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *Action) GetSelectorDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorDialect
+	}
+	return SelectorDialect_Css
+}
+
+type Filter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         FilterField            `protobuf:"varint,1,opt,name=field,proto3,enum=rssalchemy.FilterField" json:"field"`
+	Match         FilterMatch            `protobuf:"varint,2,opt,name=match,proto3,enum=rssalchemy.FilterMatch" json:"match"`
+	Pattern       string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern" validate:"required,max=200"`
+	Exclude       bool                   `protobuf:"varint,4,opt,name=exclude,proto3" json:"exclude"`
+	CaseSensitive bool                   `protobuf:"varint,5,opt,name=case_sensitive,json=caseSensitive,proto3" json:"case_sensitive"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	mi := &file_proto_specs_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_specs_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Filter) GetField() FilterField {
+	if x != nil {
+		return x.Field
+	}
+	return FilterField_Title
+}
+
+func (x *Filter) GetMatch() FilterMatch {
+	if x != nil {
+		return x.Match
+	}
+	return FilterMatch_Substring
+}
+
+func (x *Filter) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Filter) GetExclude() bool {
+	if x != nil {
+		return x.Exclude
+	}
+	return false
+}
+
+func (x *Filter) GetCaseSensitive() bool {
+	if x != nil {
+		return x.CaseSensitive
+	}
+	return false
+}
+
+type Transform struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field" validate:"oneof=title link description author content created updated enclosure"`
+	Type          TransformType          `protobuf:"varint,2,opt,name=type,proto3,enum=rssalchemy.TransformType" json:"type"`
+	Pattern       string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern" validate:"max=200"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value" validate:"max=500"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transform) Reset() {
+	*x = Transform{}
+	mi := &file_proto_specs_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transform) ProtoMessage() {}
+
+func (x *Transform) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_specs_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transform.ProtoReflect.Descriptor instead.
+func (*Transform) Descriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Transform) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Transform) GetType() TransformType {
+	if x != nil {
+		return x.Type
+	}
+	return TransformType_RegexExtract
+}
+
+func (x *Transform) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Transform) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type Specs struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Url                  string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url" validate:"url"`
-	SelectorPost         string                 `protobuf:"bytes,2,opt,name=selector_post,json=selectorPost,proto3" json:"selector_post" validate:"selector"`
-	SelectorTitle        string                 `protobuf:"bytes,3,opt,name=selector_title,json=selectorTitle,proto3" json:"selector_title" validate:"selector"`
-	SelectorLink         string                 `protobuf:"bytes,4,opt,name=selector_link,json=selectorLink,proto3" json:"selector_link" validate:"selector"`
-	SelectorDescription  string                 `protobuf:"bytes,5,opt,name=selector_description,json=selectorDescription,proto3" json:"selector_description" validate:"omitempty,selector"`
-	SelectorAuthor       string                 `protobuf:"bytes,6,opt,name=selector_author,json=selectorAuthor,proto3" json:"selector_author" validate:"omitempty,selector"`
-	SelectorCreated      string                 `protobuf:"bytes,7,opt,name=selector_created,json=selectorCreated,proto3" json:"selector_created" validate:"selector"`
-	CreatedExtractFrom   ExtractFrom            `protobuf:"varint,11,opt,name=created_extract_from,json=createdExtractFrom,proto3,enum=rssalchemy.ExtractFrom" json:"created_extract_from"`
-	CreatedAttributeName string                 `protobuf:"bytes,12,opt,name=created_attribute_name,json=createdAttributeName,proto3" json:"created_attribute_name"`
-	SelectorContent      string                 `protobuf:"bytes,8,opt,name=selector_content,json=selectorContent,proto3" json:"selector_content" validate:"omitempty,selector"`
-	SelectorEnclosure    string                 `protobuf:"bytes,9,opt,name=selector_enclosure,json=selectorEnclosure,proto3" json:"selector_enclosure" validate:"selector"`
-	CacheLifetime        string                 `protobuf:"bytes,10,opt,name=cache_lifetime,json=cacheLifetime,proto3" json:"cache_lifetime"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Url                        string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url" validate:"url"`
+	SelectorPost               string                 `protobuf:"bytes,2,opt,name=selector_post,json=selectorPost,proto3" json:"selector_post" validate:"required_if=SourceType 0 StructuredData 0,omitempty,selector=SelectorPostDialect"`
+	SelectorTitle              string                 `protobuf:"bytes,3,opt,name=selector_title,json=selectorTitle,proto3" json:"selector_title" validate:"required_if=SourceType 0 StructuredData 0,omitempty,selector=SelectorTitleDialect"`
+	SelectorLink               string                 `protobuf:"bytes,4,opt,name=selector_link,json=selectorLink,proto3" json:"selector_link" validate:"required_if=SourceType 0 StructuredData 0,omitempty,selector=SelectorLinkDialect"`
+	SelectorDescription        string                 `protobuf:"bytes,5,opt,name=selector_description,json=selectorDescription,proto3" json:"selector_description" validate:"omitempty,selector=SelectorDescriptionDialect"`
+	SelectorAuthor             string                 `protobuf:"bytes,6,opt,name=selector_author,json=selectorAuthor,proto3" json:"selector_author" validate:"omitempty,selector=SelectorAuthorDialect"`
+	SelectorCreated            string                 `protobuf:"bytes,7,opt,name=selector_created,json=selectorCreated,proto3" json:"selector_created" validate:"omitempty,selector=SelectorCreatedDialect"`
+	CreatedExtractFrom         ExtractFrom            `protobuf:"varint,11,opt,name=created_extract_from,json=createdExtractFrom,proto3,enum=rssalchemy.ExtractFrom" json:"created_extract_from"`
+	CreatedAttributeName       string                 `protobuf:"bytes,12,opt,name=created_attribute_name,json=createdAttributeName,proto3" json:"created_attribute_name" validate:"required_if=CreatedExtractFrom 1"`
+	SelectorContent            string                 `protobuf:"bytes,8,opt,name=selector_content,json=selectorContent,proto3" json:"selector_content" validate:"omitempty,selector=SelectorContentDialect"`
+	SelectorEnclosure          string                 `protobuf:"bytes,9,opt,name=selector_enclosure,json=selectorEnclosure,proto3" json:"selector_enclosure" validate:"omitempty,selector=SelectorEnclosureDialect"`
+	CacheLifetime              string                 `protobuf:"bytes,10,opt,name=cache_lifetime,json=cacheLifetime,proto3" json:"cache_lifetime"`
+	Format                     FeedFormat             `protobuf:"varint,13,opt,name=format,proto3,enum=rssalchemy.FeedFormat" json:"format"`
+	ArchiveItems               int32                  `protobuf:"varint,14,opt,name=archive_items,json=archiveItems,proto3" json:"archive_items" validate:"gte=0"`
+	ArchiveDays                int32                  `protobuf:"varint,15,opt,name=archive_days,json=archiveDays,proto3" json:"archive_days" validate:"gte=0"`
+	IdStrategy                 IdStrategy             `protobuf:"varint,16,opt,name=id_strategy,json=idStrategy,proto3,enum=rssalchemy.IdStrategy" json:"id_strategy"`
+	SelectorId                 string                 `protobuf:"bytes,17,opt,name=selector_id,json=selectorId,proto3" json:"selector_id" validate:"required_if=IdStrategy 2,omitempty,selector=SelectorIdDialect"`
+	IdAttributeName            string                 `protobuf:"bytes,18,opt,name=id_attribute_name,json=idAttributeName,proto3" json:"id_attribute_name"`
+	IdFields                   []string               `protobuf:"bytes,19,rep,name=id_fields,json=idFields,proto3" json:"id_fields" validate:"required_if=IdStrategy 3,dive,oneof=title link description author content created"`
+	FeedTitle                  string                 `protobuf:"bytes,20,opt,name=feed_title,json=feedTitle,proto3" json:"feed_title"`
+	FeedSubtitle               string                 `protobuf:"bytes,21,opt,name=feed_subtitle,json=feedSubtitle,proto3" json:"feed_subtitle"`
+	FulltextMode               FullTextMode           `protobuf:"varint,22,opt,name=fulltext_mode,json=fulltextMode,proto3,enum=rssalchemy.FullTextMode" json:"fulltext_mode"`
+	SelectorFulltext           string                 `protobuf:"bytes,23,opt,name=selector_fulltext,json=selectorFulltext,proto3" json:"selector_fulltext" validate:"required_if=FulltextMode 1,omitempty,selector=SelectorFulltextDialect"`
+	SelectorNextPage           string                 `protobuf:"bytes,24,opt,name=selector_next_page,json=selectorNextPage,proto3" json:"selector_next_page" validate:"omitempty,selector=SelectorNextPageDialect"`
+	MaxPages                   int32                  `protobuf:"varint,25,opt,name=max_pages,json=maxPages,proto3" json:"max_pages" validate:"gte=0,lte=10"`
+	ScrollSteps                int32                  `protobuf:"varint,26,opt,name=scroll_steps,json=scrollSteps,proto3" json:"scroll_steps" validate:"required_with=SelectorLoadMore,gte=0,lte=20"`
+	SelectorLoadMore           string                 `protobuf:"bytes,27,opt,name=selector_load_more,json=selectorLoadMore,proto3" json:"selector_load_more" validate:"omitempty,selector=SelectorLoadMoreDialect"`
+	TargetItems                int32                  `protobuf:"varint,28,opt,name=target_items,json=targetItems,proto3" json:"target_items" validate:"gte=0,lte=500"`
+	Actions                    []*Action              `protobuf:"bytes,29,rep,name=actions,proto3" json:"actions" validate:"max=20,dive"`
+	Filters                    []*Filter              `protobuf:"bytes,30,rep,name=filters,proto3" json:"filters" validate:"max=20,dive"`
+	FilterMode                 FilterMode             `protobuf:"varint,31,opt,name=filter_mode,json=filterMode,proto3,enum=rssalchemy.FilterMode" json:"filter_mode"`
+	Transforms                 []*Transform           `protobuf:"bytes,32,rep,name=transforms,proto3" json:"transforms" validate:"max=50,dive"`
+	TitleExtractFrom           ExtractFrom            `protobuf:"varint,33,opt,name=title_extract_from,json=titleExtractFrom,proto3,enum=rssalchemy.ExtractFrom" json:"title_extract_from"`
+	TitleAttributeName         string                 `protobuf:"bytes,34,opt,name=title_attribute_name,json=titleAttributeName,proto3" json:"title_attribute_name" validate:"required_if=TitleExtractFrom 1"`
+	LinkExtractFrom            ExtractFrom            `protobuf:"varint,35,opt,name=link_extract_from,json=linkExtractFrom,proto3,enum=rssalchemy.ExtractFrom" json:"link_extract_from"`
+	LinkAttributeName          string                 `protobuf:"bytes,36,opt,name=link_attribute_name,json=linkAttributeName,proto3" json:"link_attribute_name"`
+	DescriptionExtractFrom     ExtractFrom            `protobuf:"varint,37,opt,name=description_extract_from,json=descriptionExtractFrom,proto3,enum=rssalchemy.ExtractFrom" json:"description_extract_from"`
+	DescriptionAttributeName   string                 `protobuf:"bytes,38,opt,name=description_attribute_name,json=descriptionAttributeName,proto3" json:"description_attribute_name" validate:"required_if=DescriptionExtractFrom 1"`
+	AuthorExtractFrom          ExtractFrom            `protobuf:"varint,39,opt,name=author_extract_from,json=authorExtractFrom,proto3,enum=rssalchemy.ExtractFrom" json:"author_extract_from"`
+	AuthorAttributeName        string                 `protobuf:"bytes,40,opt,name=author_attribute_name,json=authorAttributeName,proto3" json:"author_attribute_name" validate:"required_if=AuthorExtractFrom 1"`
+	EnclosureExtractFrom       ExtractFrom            `protobuf:"varint,41,opt,name=enclosure_extract_from,json=enclosureExtractFrom,proto3,enum=rssalchemy.ExtractFrom" json:"enclosure_extract_from"`
+	EnclosureAttributeName     string                 `protobuf:"bytes,42,opt,name=enclosure_attribute_name,json=enclosureAttributeName,proto3" json:"enclosure_attribute_name"`
+	SelectorUpdated            string                 `protobuf:"bytes,43,opt,name=selector_updated,json=selectorUpdated,proto3" json:"selector_updated" validate:"omitempty,selector=SelectorUpdatedDialect"`
+	UpdatedExtractFrom         ExtractFrom            `protobuf:"varint,44,opt,name=updated_extract_from,json=updatedExtractFrom,proto3,enum=rssalchemy.ExtractFrom" json:"updated_extract_from"`
+	UpdatedAttributeName       string                 `protobuf:"bytes,45,opt,name=updated_attribute_name,json=updatedAttributeName,proto3" json:"updated_attribute_name" validate:"required_if=UpdatedExtractFrom 1"`
+	SelectorPostDialect        SelectorDialect        `protobuf:"varint,46,opt,name=selector_post_dialect,json=selectorPostDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_post_dialect"`
+	SelectorTitleDialect       SelectorDialect        `protobuf:"varint,47,opt,name=selector_title_dialect,json=selectorTitleDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_title_dialect"`
+	SelectorLinkDialect        SelectorDialect        `protobuf:"varint,48,opt,name=selector_link_dialect,json=selectorLinkDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_link_dialect"`
+	SelectorDescriptionDialect SelectorDialect        `protobuf:"varint,49,opt,name=selector_description_dialect,json=selectorDescriptionDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_description_dialect"`
+	SelectorAuthorDialect      SelectorDialect        `protobuf:"varint,50,opt,name=selector_author_dialect,json=selectorAuthorDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_author_dialect"`
+	SelectorCreatedDialect     SelectorDialect        `protobuf:"varint,51,opt,name=selector_created_dialect,json=selectorCreatedDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_created_dialect"`
+	SelectorContentDialect     SelectorDialect        `protobuf:"varint,52,opt,name=selector_content_dialect,json=selectorContentDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_content_dialect"`
+	SelectorEnclosureDialect   SelectorDialect        `protobuf:"varint,53,opt,name=selector_enclosure_dialect,json=selectorEnclosureDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_enclosure_dialect"`
+	SelectorIdDialect          SelectorDialect        `protobuf:"varint,54,opt,name=selector_id_dialect,json=selectorIdDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_id_dialect"`
+	SelectorFulltextDialect    SelectorDialect        `protobuf:"varint,55,opt,name=selector_fulltext_dialect,json=selectorFulltextDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_fulltext_dialect"`
+	SelectorNextPageDialect    SelectorDialect        `protobuf:"varint,56,opt,name=selector_next_page_dialect,json=selectorNextPageDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_next_page_dialect"`
+	SelectorLoadMoreDialect    SelectorDialect        `protobuf:"varint,57,opt,name=selector_load_more_dialect,json=selectorLoadMoreDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_load_more_dialect"`
+	SelectorUpdatedDialect     SelectorDialect        `protobuf:"varint,58,opt,name=selector_updated_dialect,json=selectorUpdatedDialect,proto3,enum=rssalchemy.SelectorDialect" json:"selector_updated_dialect"`
+	RenderMode                 RenderMode             `protobuf:"varint,59,opt,name=render_mode,json=renderMode,proto3,enum=rssalchemy.RenderMode" json:"render_mode"`
+	SourceType                 SourceType             `protobuf:"varint,60,opt,name=source_type,json=sourceType,proto3,enum=rssalchemy.SourceType" json:"source_type"`
+	JsonMethod                 HttpMethod             `protobuf:"varint,61,opt,name=json_method,json=jsonMethod,proto3,enum=rssalchemy.HttpMethod" json:"json_method"`
+	JsonBody                   string                 `protobuf:"bytes,62,opt,name=json_body,json=jsonBody,proto3" json:"json_body" validate:"max=10000"`
+	JsonQueryLanguage          JsonQueryLanguage      `protobuf:"varint,63,opt,name=json_query_language,json=jsonQueryLanguage,proto3,enum=rssalchemy.JsonQueryLanguage" json:"json_query_language"`
+	JsonItems                  string                 `protobuf:"bytes,64,opt,name=json_items,json=jsonItems,proto3" json:"json_items" validate:"required_if=SourceType 1,max=500"`
+	JsonTitle                  string                 `protobuf:"bytes,65,opt,name=json_title,json=jsonTitle,proto3" json:"json_title" validate:"required_if=SourceType 1,max=500"`
+	JsonLink                   string                 `protobuf:"bytes,66,opt,name=json_link,json=jsonLink,proto3" json:"json_link" validate:"required_if=SourceType 1,max=500"`
+	JsonDescription            string                 `protobuf:"bytes,67,opt,name=json_description,json=jsonDescription,proto3" json:"json_description" validate:"max=500"`
+	JsonContent                string                 `protobuf:"bytes,68,opt,name=json_content,json=jsonContent,proto3" json:"json_content" validate:"max=500"`
+	JsonAuthor                 string                 `protobuf:"bytes,69,opt,name=json_author,json=jsonAuthor,proto3" json:"json_author" validate:"max=500"`
+	JsonCreated                string                 `protobuf:"bytes,70,opt,name=json_created,json=jsonCreated,proto3" json:"json_created" validate:"max=500"`
+	JsonEnclosure              string                 `protobuf:"bytes,71,opt,name=json_enclosure,json=jsonEnclosure,proto3" json:"json_enclosure" validate:"max=500"`
+	StructuredData             StructuredDataMode     `protobuf:"varint,72,opt,name=structured_data,json=structuredData,proto3,enum=rssalchemy.StructuredDataMode" json:"structured_data"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *Specs) Reset() {
+	*x = Specs{}
+	mi := &file_proto_specs_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Specs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Specs) ProtoMessage() {}
+
+func (x *Specs) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_specs_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Specs.ProtoReflect.Descriptor instead.
+func (*Specs) Descriptor() ([]byte, []int) {
+	return file_proto_specs_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Specs) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Specs) GetSelectorPost() string {
+	if x != nil {
+		return x.SelectorPost
+	}
+	return ""
+}
+
+func (x *Specs) GetSelectorTitle() string {
+	if x != nil {
+		return x.SelectorTitle
+	}
+	return ""
+}
+
+func (x *Specs) GetSelectorLink() string {
+	if x != nil {
+		return x.SelectorLink
+	}
+	return ""
+}
+
+func (x *Specs) GetSelectorDescription() string {
+	if x != nil {
+		return x.SelectorDescription
+	}
+	return ""
+}
+
+func (x *Specs) GetSelectorAuthor() string {
+	if x != nil {
+		return x.SelectorAuthor
+	}
+	return ""
+}
+
+func (x *Specs) GetSelectorCreated() string {
+	if x != nil {
+		return x.SelectorCreated
+	}
+	return ""
+}
+
+func (x *Specs) GetCreatedExtractFrom() ExtractFrom {
+	if x != nil {
+		return x.CreatedExtractFrom
+	}
+	return ExtractFrom_InnerText
+}
+
+func (x *Specs) GetCreatedAttributeName() string {
+	if x != nil {
+		return x.CreatedAttributeName
+	}
+	return ""
+}
+
+func (x *Specs) GetSelectorContent() string {
+	if x != nil {
+		return x.SelectorContent
+	}
+	return ""
 }
 
-func (x *Specs) Reset() {
-	*x = Specs{}
-	mi := &file_proto_specs_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Specs) GetSelectorEnclosure() string {
+	if x != nil {
+		return x.SelectorEnclosure
+	}
+	return ""
 }
 
-func (x *Specs) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Specs) GetCacheLifetime() string {
+	if x != nil {
+		return x.CacheLifetime
+	}
+	return ""
 }
 
-func (*Specs) ProtoMessage() {}
+func (x *Specs) GetFormat() FeedFormat {
+	if x != nil {
+		return x.Format
+	}
+	return FeedFormat_Atom
+}
 
-func (x *Specs) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_specs_proto_msgTypes[0]
+func (x *Specs) GetArchiveItems() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ArchiveItems
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Specs.ProtoReflect.Descriptor instead.
-func (*Specs) Descriptor() ([]byte, []int) {
-	return file_proto_specs_proto_rawDescGZIP(), []int{0}
+func (x *Specs) GetArchiveDays() int32 {
+	if x != nil {
+		return x.ArchiveDays
+	}
+	return 0
 }
 
-func (x *Specs) GetUrl() string {
+func (x *Specs) GetIdStrategy() IdStrategy {
 	if x != nil {
-		return x.Url
+		return x.IdStrategy
+	}
+	return IdStrategy_LinkDate
+}
+
+func (x *Specs) GetSelectorId() string {
+	if x != nil {
+		return x.SelectorId
 	}
 	return ""
 }
 
-func (x *Specs) GetSelectorPost() string {
+func (x *Specs) GetIdAttributeName() string {
 	if x != nil {
-		return x.SelectorPost
+		return x.IdAttributeName
 	}
 	return ""
 }
 
-func (x *Specs) GetSelectorTitle() string {
+func (x *Specs) GetIdFields() []string {
 	if x != nil {
-		return x.SelectorTitle
+		return x.IdFields
+	}
+	return nil
+}
+
+func (x *Specs) GetFeedTitle() string {
+	if x != nil {
+		return x.FeedTitle
 	}
 	return ""
 }
 
-func (x *Specs) GetSelectorLink() string {
+func (x *Specs) GetFeedSubtitle() string {
 	if x != nil {
-		return x.SelectorLink
+		return x.FeedSubtitle
 	}
 	return ""
 }
 
-func (x *Specs) GetSelectorDescription() string {
+func (x *Specs) GetFulltextMode() FullTextMode {
 	if x != nil {
-		return x.SelectorDescription
+		return x.FulltextMode
+	}
+	return FullTextMode_Off
+}
+
+func (x *Specs) GetSelectorFulltext() string {
+	if x != nil {
+		return x.SelectorFulltext
 	}
 	return ""
 }
 
-func (x *Specs) GetSelectorAuthor() string {
+func (x *Specs) GetSelectorNextPage() string {
 	if x != nil {
-		return x.SelectorAuthor
+		return x.SelectorNextPage
 	}
 	return ""
 }
 
-func (x *Specs) GetSelectorCreated() string {
+func (x *Specs) GetMaxPages() int32 {
 	if x != nil {
-		return x.SelectorCreated
+		return x.MaxPages
+	}
+	return 0
+}
+
+func (x *Specs) GetScrollSteps() int32 {
+	if x != nil {
+		return x.ScrollSteps
+	}
+	return 0
+}
+
+func (x *Specs) GetSelectorLoadMore() string {
+	if x != nil {
+		return x.SelectorLoadMore
 	}
 	return ""
 }
 
-func (x *Specs) GetCreatedExtractFrom() ExtractFrom {
+func (x *Specs) GetTargetItems() int32 {
 	if x != nil {
-		return x.CreatedExtractFrom
+		return x.TargetItems
+	}
+	return 0
+}
+
+func (x *Specs) GetActions() []*Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *Specs) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *Specs) GetFilterMode() FilterMode {
+	if x != nil {
+		return x.FilterMode
+	}
+	return FilterMode_All
+}
+
+func (x *Specs) GetTransforms() []*Transform {
+	if x != nil {
+		return x.Transforms
+	}
+	return nil
+}
+
+func (x *Specs) GetTitleExtractFrom() ExtractFrom {
+	if x != nil {
+		return x.TitleExtractFrom
 	}
 	return ExtractFrom_InnerText
 }
 
-func (x *Specs) GetCreatedAttributeName() string {
+func (x *Specs) GetTitleAttributeName() string {
 	if x != nil {
-		return x.CreatedAttributeName
+		return x.TitleAttributeName
 	}
 	return ""
 }
 
-func (x *Specs) GetSelectorContent() string {
+func (x *Specs) GetLinkExtractFrom() ExtractFrom {
 	if x != nil {
-		return x.SelectorContent
+		return x.LinkExtractFrom
+	}
+	return ExtractFrom_InnerText
+}
+
+func (x *Specs) GetLinkAttributeName() string {
+	if x != nil {
+		return x.LinkAttributeName
 	}
 	return ""
 }
 
-func (x *Specs) GetSelectorEnclosure() string {
+func (x *Specs) GetDescriptionExtractFrom() ExtractFrom {
 	if x != nil {
-		return x.SelectorEnclosure
+		return x.DescriptionExtractFrom
+	}
+	return ExtractFrom_InnerText
+}
+
+func (x *Specs) GetDescriptionAttributeName() string {
+	if x != nil {
+		return x.DescriptionAttributeName
 	}
 	return ""
 }
 
-func (x *Specs) GetCacheLifetime() string {
+func (x *Specs) GetAuthorExtractFrom() ExtractFrom {
 	if x != nil {
-		return x.CacheLifetime
+		return x.AuthorExtractFrom
+	}
+	return ExtractFrom_InnerText
+}
+
+func (x *Specs) GetAuthorAttributeName() string {
+	if x != nil {
+		return x.AuthorAttributeName
+	}
+	return ""
+}
+
+func (x *Specs) GetEnclosureExtractFrom() ExtractFrom {
+	if x != nil {
+		return x.EnclosureExtractFrom
+	}
+	return ExtractFrom_InnerText
+}
+
+func (x *Specs) GetEnclosureAttributeName() string {
+	if x != nil {
+		return x.EnclosureAttributeName
+	}
+	return ""
+}
+
+func (x *Specs) GetSelectorUpdated() string {
+	if x != nil {
+		return x.SelectorUpdated
 	}
 	return ""
 }
 
+func (x *Specs) GetUpdatedExtractFrom() ExtractFrom {
+	if x != nil {
+		return x.UpdatedExtractFrom
+	}
+	return ExtractFrom_InnerText
+}
+
+func (x *Specs) GetUpdatedAttributeName() string {
+	if x != nil {
+		return x.UpdatedAttributeName
+	}
+	return ""
+}
+
+func (x *Specs) GetSelectorPostDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorPostDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorTitleDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorTitleDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorLinkDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorLinkDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorDescriptionDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorDescriptionDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorAuthorDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorAuthorDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorCreatedDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorCreatedDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorContentDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorContentDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorEnclosureDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorEnclosureDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorIdDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorIdDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorFulltextDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorFulltextDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorNextPageDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorNextPageDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorLoadMoreDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorLoadMoreDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetSelectorUpdatedDialect() SelectorDialect {
+	if x != nil {
+		return x.SelectorUpdatedDialect
+	}
+	return SelectorDialect_Css
+}
+
+func (x *Specs) GetRenderMode() RenderMode {
+	if x != nil {
+		return x.RenderMode
+	}
+	return RenderMode_Browser
+}
+
+func (x *Specs) GetSourceType() SourceType {
+	if x != nil {
+		return x.SourceType
+	}
+	return SourceType_Page
+}
+
+func (x *Specs) GetJsonMethod() HttpMethod {
+	if x != nil {
+		return x.JsonMethod
+	}
+	return HttpMethod_Get
+}
+
+func (x *Specs) GetJsonBody() string {
+	if x != nil {
+		return x.JsonBody
+	}
+	return ""
+}
+
+func (x *Specs) GetJsonQueryLanguage() JsonQueryLanguage {
+	if x != nil {
+		return x.JsonQueryLanguage
+	}
+	return JsonQueryLanguage_JsonPath
+}
+
+func (x *Specs) GetJsonItems() string {
+	if x != nil {
+		return x.JsonItems
+	}
+	return ""
+}
+
+func (x *Specs) GetJsonTitle() string {
+	if x != nil {
+		return x.JsonTitle
+	}
+	return ""
+}
+
+func (x *Specs) GetJsonLink() string {
+	if x != nil {
+		return x.JsonLink
+	}
+	return ""
+}
+
+func (x *Specs) GetJsonDescription() string {
+	if x != nil {
+		return x.JsonDescription
+	}
+	return ""
+}
+
+func (x *Specs) GetJsonContent() string {
+	if x != nil {
+		return x.JsonContent
+	}
+	return ""
+}
+
+func (x *Specs) GetJsonAuthor() string {
+	if x != nil {
+		return x.JsonAuthor
+	}
+	return ""
+}
+
+func (x *Specs) GetJsonCreated() string {
+	if x != nil {
+		return x.JsonCreated
+	}
+	return ""
+}
+
+func (x *Specs) GetJsonEnclosure() string {
+	if x != nil {
+		return x.JsonEnclosure
+	}
+	return ""
+}
+
+func (x *Specs) GetStructuredData() StructuredDataMode {
+	if x != nil {
+		return x.StructuredData
+	}
+	return StructuredDataMode_StructuredDataOff
+}
+
 var File_proto_specs_proto protoreflect.FileDescriptor
 
 var file_proto_specs_proto_rawDesc = string([]byte{
 	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x1a,
 	0x13, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x08, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x63, 0x73, 0x12, 0x30,
-	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x9a, 0x84, 0x9e,
-	0x03, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x72, 0x6c, 0x22, 0x20, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x75, 0x72, 0x6c, 0x22, 0x52, 0x03, 0x75, 0x72, 0x6c,
-	0x12, 0x52, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x9a, 0x84, 0x9e, 0x03, 0x28, 0x6a, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x03, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x10, 0x9a, 0x84, 0x9e, 0x03, 0x0b, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x5e, 0x0a,
+	0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x42, 0x9a, 0x84, 0x9e, 0x03, 0x3d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65,
+	0x63, 0x74, 0x22, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x9a, 0x84,
+	0x9e, 0x03, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x31, 0x9a, 0x84, 0x9e, 0x03,
+	0x2c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d,
+	0x73, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x67, 0x74, 0x65,
+	0x3d, 0x30, 0x2c, 0x6c, 0x74, 0x65, 0x3d, 0x33, 0x30, 0x30, 0x30, 0x30, 0x22, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0x9a, 0x84, 0x9e, 0x03,
+	0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22,
+	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x64, 0x0a, 0x10, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d,
+	0x79, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63,
+	0x74, 0x42, 0x1c, 0x9a, 0x84, 0x9e, 0x03, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52,
+	0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74,
+	0x22, 0xc9, 0x02, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x73,
+	0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x42, 0x11, 0x9a, 0x84, 0x9e, 0x03, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x40, 0x0a,
+	0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72,
+	0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x11, 0x9a, 0x84, 0x9e, 0x03, 0x0c, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x49, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2f, 0x9a, 0x84, 0x9e, 0x03, 0x2a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x32, 0x30, 0x30,
+	0x22, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x13, 0x9a, 0x84, 0x9e,
+	0x03, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22,
+	0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x61, 0x73,
+	0x65, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x1a, 0x9a, 0x84, 0x9e, 0x03, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x61,
+	0x73, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0x52, 0x0d, 0x63,
+	0x61, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xc4, 0x02, 0x0a,
+	0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x78, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x62, 0x9a, 0x84, 0x9e, 0x03, 0x5d,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x20, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x20, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x20, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x22, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x42, 0x10, 0x9a,
+	0x84, 0x9e, 0x03, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x9a, 0x84, 0x9e, 0x03, 0x21, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x3d, 0x32, 0x30, 0x30, 0x22, 0x52, 0x07,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x9a, 0x84, 0x9e, 0x03, 0x1f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x30, 0x22, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xf0, 0x3c, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x63, 0x73, 0x12, 0x30, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x9a, 0x84, 0x9e, 0x03,
+	0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x72, 0x6c, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x75, 0x72, 0x6c, 0x22, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x9a, 0x01, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x75, 0x9a, 0x84, 0x9e, 0x03, 0x70, 0x6a, 0x73,
 	0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x50, 0x6f, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x0e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0x9a, 0x84,
-	0x9e, 0x03, 0x29, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x52, 0x0d, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x2d, 0x9a, 0x84, 0x9e, 0x03, 0x28, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x20, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x22, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
-	0x71, 0x0a, 0x14, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0x9a,
-	0x84, 0x9e, 0x03, 0x39, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20,
+	0x74, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x3d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x20, 0x30, 0x20, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x20, 0x30, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x0c,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x9e, 0x01, 0x0a,
+	0x0e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x77, 0x9a, 0x84, 0x9e, 0x03, 0x72, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x3d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x20, 0x30, 0x20, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x20, 0x30, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x0d,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x9a, 0x01,
+	0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x75, 0x9a, 0x84, 0x9e, 0x03, 0x70, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x22,
+	0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x3d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x20, 0x30, 0x20, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x20, 0x30, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x0c, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x8c, 0x01, 0x0a, 0x14, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x59, 0x9a, 0x84, 0x9e, 0x03, 0x54,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x61, 0x6c,
+	0x65, 0x63, 0x74, 0x22, 0x52, 0x13, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x78, 0x0a, 0x0f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x4f, 0x9a, 0x84, 0x9e, 0x03, 0x4a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x20,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65,
+	0x63, 0x74, 0x22, 0x52, 0x0e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x7c, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x51, 0x9a,
+	0x84, 0x9e, 0x03, 0x4c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22,
+	0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x6b, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x20, 0x9a, 0x84, 0x9e, 0x03, 0x1b, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x52, 0x12, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x84,
+	0x01, 0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x4e, 0x9a, 0x84, 0x9e, 0x03, 0x49, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x3d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x31, 0x22, 0x52,
+	0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x7c, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x51, 0x9a, 0x84, 0x9e, 0x03, 0x4c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63,
+	0x74, 0x22, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x55, 0x9a, 0x84, 0x9e, 0x03, 0x50, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x22,
+	0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x44,
+	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x11, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x45, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1a, 0x9a, 0x84, 0x9e, 0x03, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0d,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x12, 0x9a, 0x84, 0x9e, 0x03, 0x0d, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x4f, 0x0a, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2a, 0x9a, 0x84, 0x9e, 0x03, 0x25, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x67, 0x74,
+	0x65, 0x3d, 0x30, 0x22, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x4c, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x42, 0x29, 0x9a, 0x84, 0x9e, 0x03, 0x24, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x79,
+	0x73, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x67, 0x74, 0x65,
+	0x3d, 0x30, 0x22, 0x52, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x50, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65,
+	0x6d, 0x79, 0x2e, 0x49, 0x64, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x17, 0x9a,
+	0x84, 0x9e, 0x03, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x52, 0x0a, 0x69, 0x64, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x12, 0x81, 0x01, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x9a, 0x84, 0x9e, 0x03, 0x5b, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x3d, 0x49, 0x64, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x20, 0x32, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x11, 0x69, 0x64, 0x5f, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1d, 0x9a, 0x84, 0x9e, 0x03, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x52, 0x0f, 0x69, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x8f, 0x01, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x13, 0x20, 0x03, 0x28, 0x09, 0x42, 0x72, 0x9a, 0x84, 0x9e, 0x03, 0x6d, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x20, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x69, 0x66, 0x3d, 0x49, 0x64, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x20, 0x33, 0x2c,
+	0x64, 0x69, 0x76, 0x65, 0x2c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x20, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x52, 0x08, 0x69, 0x64, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x9a, 0x84, 0x9e, 0x03, 0x11, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x52,
+	0x09, 0x66, 0x65, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x66, 0x65,
+	0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x19, 0x9a, 0x84, 0x9e, 0x03, 0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x65,
+	0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x52, 0x0c, 0x66, 0x65,
+	0x65, 0x64, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x66, 0x75,
+	0x6c, 0x6c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x46,
+	0x75, 0x6c, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x19, 0x9a, 0x84, 0x9e,
+	0x03, 0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x75, 0x6c, 0x6c, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x52, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x74, 0x65, 0x78, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x9b, 0x01, 0x0a, 0x11, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x74, 0x65, 0x78, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x6e, 0x9a, 0x84, 0x9e, 0x03, 0x69, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x74, 0x65, 0x78, 0x74, 0x22, 0x20,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x5f, 0x69, 0x66, 0x3d, 0x46, 0x75, 0x6c, 0x6c, 0x74, 0x65, 0x78, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x20, 0x31, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x46, 0x75, 0x6c, 0x6c, 0x74, 0x65, 0x78, 0x74, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22,
+	0x52, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x54, 0x9a, 0x84, 0x9e, 0x03, 0x4f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0x20,
 	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x2c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x52, 0x13, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0x9a, 0x84, 0x9e,
-	0x03, 0x34, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x3a, 0x22, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x52, 0x0e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x5b, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x30, 0x9a, 0x84, 0x9e, 0x03, 0x2b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x20, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x22, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x6b, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x45,
-	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x20, 0x9a, 0x84, 0x9e, 0x03,
-	0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x52, 0x12, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d,
-	0x12, 0x58, 0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x22, 0x9a, 0x84, 0x9e, 0x03, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0x9a, 0x84, 0x9e, 0x03, 0x35, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6f, 0x6d, 0x69,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22,
-	0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x61, 0x0a, 0x12, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x6e,
-	0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0x9a,
-	0x84, 0x9e, 0x03, 0x2d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x22, 0x20, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x22, 0x52, 0x11, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6c, 0x6f,
-	0x73, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6c, 0x69,
-	0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x9a, 0x84,
-	0x9e, 0x03, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6c,
-	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0d, 0x63, 0x61, 0x63, 0x68, 0x65, 0x4c,
-	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x2a, 0x2b, 0x0a, 0x0b, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54,
-	0x65, 0x78, 0x74, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x10, 0x01, 0x42, 0x16, 0x5a, 0x14, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x74, 0x79, 0x2c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x44, 0x69, 0x61,
+	0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2d, 0x9a, 0x84, 0x9e, 0x03,
+	0x28, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x67, 0x74, 0x65, 0x3d,
+	0x30, 0x2c, 0x6c, 0x74, 0x65, 0x3d, 0x31, 0x30, 0x22, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x0c, 0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x73, 0x74,
+	0x65, 0x70, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x4f, 0x9a, 0x84, 0x9e, 0x03, 0x4a,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x3d, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x72, 0x65, 0x2c, 0x67, 0x74, 0x65,
+	0x3d, 0x30, 0x2c, 0x6c, 0x74, 0x65, 0x3d, 0x32, 0x30, 0x22, 0x52, 0x0b, 0x73, 0x63, 0x72, 0x6f,
+	0x6c, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x54, 0x9a, 0x84, 0x9e, 0x03, 0x4f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6d,
+	0x6f, 0x72, 0x65, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x3d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f,
+	0x72, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x10, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x54, 0x0a, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x31, 0x9a, 0x84, 0x9e, 0x03, 0x2c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x20, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x67, 0x74, 0x65, 0x3d, 0x30, 0x2c, 0x6c, 0x74, 0x65,
+	0x3d, 0x35, 0x30, 0x30, 0x22, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x58, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x2a, 0x9a, 0x84, 0x9e, 0x03, 0x25, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x20, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x3d, 0x32, 0x30, 0x2c, 0x64, 0x69,
+	0x76, 0x65, 0x22, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x58, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x42, 0x2a, 0x9a, 0x84, 0x9e, 0x03, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a,
+	0x22, 0x6d, 0x61, 0x78, 0x3d, 0x32, 0x30, 0x2c, 0x64, 0x69, 0x76, 0x65, 0x22, 0x52, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x73,
+	0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d,
+	0x6f, 0x64, 0x65, 0x42, 0x17, 0x9a, 0x84, 0x9e, 0x03, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x52, 0x0a, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x64, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
+	0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x42, 0x2d, 0x9a, 0x84, 0x9e, 0x03, 0x28, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x2c, 0x64, 0x69, 0x76,
+	0x65, 0x22, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x65,
+	0x0a, 0x12, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x73,
+	0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46,
+	0x72, 0x6f, 0x6d, 0x42, 0x1e, 0x9a, 0x84, 0x9e, 0x03, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x22, 0x52, 0x10, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x7c, 0x0a, 0x14, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x4a, 0x9a, 0x84, 0x9e, 0x03, 0x45, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x3d, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x31, 0x22, 0x52,
+	0x12, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x1d, 0x9a, 0x84, 0x9e, 0x03, 0x18, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x52, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x4f, 0x0a, 0x13, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x24,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x9a, 0x84, 0x9e, 0x03, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x11, 0x6c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x77, 0x0a, 0x18, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x73,
+	0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46,
+	0x72, 0x6f, 0x6d, 0x42, 0x24, 0x9a, 0x84, 0x9e, 0x03, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x52, 0x16, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x94, 0x01, 0x0a, 0x1a, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x42, 0x56, 0x9a, 0x84, 0x9e, 0x03, 0x51, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x69, 0x66, 0x3d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x31, 0x22, 0x52, 0x18,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x27, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65,
+	0x6d, 0x79, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x1f,
+	0x9a, 0x84, 0x9e, 0x03, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x52,
+	0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72,
+	0x6f, 0x6d, 0x12, 0x80, 0x01, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x28, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x4c, 0x9a, 0x84, 0x9e, 0x03, 0x47, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x3d, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x31, 0x22,
+	0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x71, 0x0a, 0x16, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75,
+	0x72, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x29, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65,
+	0x6d, 0x79, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x22,
+	0x9a, 0x84, 0x9e, 0x03, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e, 0x63, 0x6c, 0x6f,
+	0x73, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x22, 0x52, 0x14, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x5e, 0x0a, 0x18, 0x65, 0x6e, 0x63, 0x6c,
+	0x6f, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x9a, 0x84, 0x9e, 0x03,
+	0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x52, 0x16, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x7c, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x2b, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x51, 0x9a, 0x84, 0x9e, 0x03, 0x4c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x3d, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x69, 0x61,
+	0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x6b, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x2c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d,
+	0x79, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x20, 0x9a,
+	0x84, 0x9e, 0x03, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x52,
+	0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x84, 0x01, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x2d,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x4e, 0x9a, 0x84, 0x9e, 0x03, 0x49, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x3d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x20, 0x31, 0x22, 0x52, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x6c,
+	0x65, 0x63, 0x74, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x73, 0x61,
+	0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44,
+	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x21, 0x9a, 0x84, 0x9e, 0x03, 0x1c, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x13, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x75,
+	0x0a, 0x16, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x22, 0x9a, 0x84, 0x9e,
+	0x03, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52,
+	0x14, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x44, 0x69,
+	0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x30,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d,
+	0x79, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63,
+	0x74, 0x42, 0x21, 0x9a, 0x84, 0x9e, 0x03, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x69, 0x61, 0x6c,
+	0x65, 0x63, 0x74, 0x22, 0x52, 0x13, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69,
+	0x6e, 0x6b, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x28, 0x9a,
+	0x84, 0x9e, 0x03, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x1a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x61, 0x6c,
+	0x65, 0x63, 0x74, 0x12, 0x78, 0x0a, 0x17, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x32,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d,
+	0x79, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63,
+	0x74, 0x42, 0x23, 0x9a, 0x84, 0x9e, 0x03, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x64, 0x69,
+	0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x15, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x7b, 0x0a,
+	0x18, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x24, 0x9a, 0x84,
+	0x9e, 0x03, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63,
+	0x74, 0x22, 0x52, 0x16, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x7b, 0x0a, 0x18, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x64,
+	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72,
+	0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x24, 0x9a, 0x84, 0x9e, 0x03, 0x1f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52,
+	0x16, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x1a, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x64,
+	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72,
+	0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x26, 0x9a, 0x84, 0x9e, 0x03, 0x21,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65,
+	0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74,
+	0x22, 0x52, 0x18, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6c, 0x6f,
+	0x73, 0x75, 0x72, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x6c, 0x0a, 0x13, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65,
+	0x63, 0x74, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c,
+	0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69,
+	0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x1f, 0x9a, 0x84, 0x9e, 0x03, 0x1a, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x64, 0x69,
+	0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x11, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x7e, 0x0a, 0x19, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x64,
+	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72,
+	0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x25, 0x9a, 0x84, 0x9e, 0x03, 0x20,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x66,
+	0x75, 0x6c, 0x6c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22,
+	0x52, 0x17, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x74, 0x65,
+	0x78, 0x74, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x1a, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x26, 0x9a, 0x84, 0x9e,
+	0x03, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65,
+	0x63, 0x74, 0x22, 0x52, 0x17, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x80, 0x01, 0x0a,
+	0x1a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6d,
+	0x6f, 0x72, 0x65, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x39, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x26,
+	0x9a, 0x84, 0x9e, 0x03, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x69,
+	0x61, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x52, 0x17, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12,
+	0x7b, 0x0a, 0x18, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x3a, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x24,
+	0x9a, 0x84, 0x9e, 0x03, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x61, 0x6c,
+	0x65, 0x63, 0x74, 0x22, 0x52, 0x16, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x50, 0x0a, 0x0b,
+	0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x3b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x17, 0x9a, 0x84, 0x9e, 0x03, 0x12,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x22, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x50,
+	0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x3c, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x17, 0x9a, 0x84, 0x9e,
+	0x03, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x50, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x3d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c, 0x63, 0x68, 0x65,
+	0x6d, 0x79, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x17, 0x9a,
+	0x84, 0x9e, 0x03, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x47, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x3e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x9a, 0x84, 0x9e, 0x03, 0x25, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x20, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x30, 0x30, 0x30, 0x30,
+	0x22, 0x52, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x6e, 0x0a, 0x13, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x72, 0x73, 0x73, 0x61, 0x6c,
+	0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x1f, 0x9a, 0x84, 0x9e, 0x03, 0x1a, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x52, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x61, 0x0a, 0x0a, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x40, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x42, 0x9a, 0x84, 0x9e, 0x03, 0x3d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a, 0x73, 0x6f, 0x6e,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x3d, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x20, 0x31, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x35,
+	0x30, 0x30, 0x22, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x61,
+	0x0a, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x41, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x42, 0x9a, 0x84, 0x9e, 0x03, 0x3d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66,
+	0x3d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x20, 0x31, 0x2c, 0x6d, 0x61,
+	0x78, 0x3d, 0x35, 0x30, 0x30, 0x22, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x5e, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x42,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x9a, 0x84, 0x9e, 0x03, 0x3c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69,
+	0x66, 0x3d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x20, 0x31, 0x2c, 0x6d,
+	0x61, 0x78, 0x3d, 0x35, 0x30, 0x30, 0x22, 0x52, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x5a, 0x0a, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x43, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x9a, 0x84, 0x9e,
+	0x03, 0x2a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x30, 0x22, 0x52, 0x0f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a,
+	0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x44, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2b, 0x9a, 0x84, 0x9e, 0x03, 0x26, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x30, 0x22,
+	0x52, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a,
+	0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x45, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2a, 0x9a, 0x84, 0x9e, 0x03, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x30, 0x22, 0x52, 0x0a,
+	0x6a, 0x73, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x4e, 0x0a, 0x0c, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2b, 0x9a, 0x84, 0x9e, 0x03, 0x26, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a, 0x73, 0x6f,
+	0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x30, 0x22, 0x52, 0x0b, 0x6a,
+	0x73, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x54, 0x0a, 0x0e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x47, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2d, 0x9a, 0x84, 0x9e, 0x03, 0x28, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x22, 0x20, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x30,
+	0x22, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65,
+	0x12, 0x64, 0x0a, 0x0f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x48, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x73, 0x61,
+	0x6c, 0x63, 0x68, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x1b, 0x9a, 0x84, 0x9e, 0x03, 0x16,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x2a, 0x4b, 0x0a, 0x0b, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65,
+	0x78, 0x74, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x48, 0x74, 0x6d, 0x6c,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x10, 0x03, 0x2a, 0x35, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44,
+	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x73, 0x73, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x58, 0x50, 0x61, 0x74, 0x68, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x6c,
+	0x61, 0x79, 0x77, 0x72, 0x69, 0x67, 0x68, 0x74, 0x10, 0x02, 0x2a, 0x2d, 0x0a, 0x0a, 0x46, 0x65,
+	0x65, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x74, 0x6f, 0x6d,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x73, 0x73, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4a,
+	0x73, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x10, 0x02, 0x2a, 0x46, 0x0a, 0x0a, 0x49, 0x64, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x44,
+	0x61, 0x74, 0x65, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x61, 0x73, 0x68, 0x10,
+	0x03, 0x2a, 0x31, 0x0a, 0x0c, 0x46, 0x75, 0x6c, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x66, 0x66, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x79,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x75,
+	0x74, 0x6f, 0x10, 0x02, 0x2a, 0x3a, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x4f, 0x72, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x10, 0x02,
+	0x2a, 0x23, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08,
+	0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4a, 0x73, 0x6f, 0x6e,
+	0x41, 0x70, 0x69, 0x10, 0x01, 0x2a, 0x5f, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x66, 0x66,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x02, 0x2a, 0x2f, 0x0a, 0x11, 0x4a, 0x73, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4a,
+	0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x6d, 0x65,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x10, 0x01, 0x2a, 0x1f, 0x0a, 0x0a, 0x48, 0x74, 0x74, 0x70, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x10, 0x01, 0x2a, 0x67, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x6c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x50,
+	0x72, 0x65, 0x73, 0x73, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x57,
+	0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x10, 0x04, 0x12,
+	0x10, 0x0a, 0x0c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x10,
+	0x05, 0x2a, 0x4c, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x09, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x10, 0x04, 0x2a,
+	0x27, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0d,
+	0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x52, 0x65, 0x67, 0x65, 0x78, 0x10, 0x01, 0x2a, 0x1e, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x6c, 0x6c, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x6e, 0x79, 0x10, 0x01, 0x2a, 0x81, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x65,
+	0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
+	0x52, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x54, 0x72, 0x69, 0x6d, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x6f, 0x77, 0x65,
+	0x72, 0x63, 0x61, 0x73, 0x65, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x70, 0x70, 0x65, 0x72,
+	0x63, 0x61, 0x73, 0x65, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x10, 0x06, 0x12, 0x0c,
+	0x0a, 0x08, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x10, 0x07, 0x42, 0x16, 0x5a, 0x14,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74,
+	0x70, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -294,19 +2257,72 @@ func file_proto_specs_proto_rawDescGZIP() []byte {
 	return file_proto_specs_proto_rawDescData
 }
 
-var file_proto_specs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_specs_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_specs_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_proto_specs_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_proto_specs_proto_goTypes = []any{
-	(ExtractFrom)(0), // 0: rssalchemy.ExtractFrom
-	(*Specs)(nil),    // 1: rssalchemy.Specs
+	(ExtractFrom)(0),        // 0: rssalchemy.ExtractFrom
+	(SelectorDialect)(0),    // 1: rssalchemy.SelectorDialect
+	(FeedFormat)(0),         // 2: rssalchemy.FeedFormat
+	(IdStrategy)(0),         // 3: rssalchemy.IdStrategy
+	(FullTextMode)(0),       // 4: rssalchemy.FullTextMode
+	(RenderMode)(0),         // 5: rssalchemy.RenderMode
+	(SourceType)(0),         // 6: rssalchemy.SourceType
+	(StructuredDataMode)(0), // 7: rssalchemy.StructuredDataMode
+	(JsonQueryLanguage)(0),  // 8: rssalchemy.JsonQueryLanguage
+	(HttpMethod)(0),         // 9: rssalchemy.HttpMethod
+	(ActionType)(0),         // 10: rssalchemy.ActionType
+	(FilterField)(0),        // 11: rssalchemy.FilterField
+	(FilterMatch)(0),        // 12: rssalchemy.FilterMatch
+	(FilterMode)(0),         // 13: rssalchemy.FilterMode
+	(TransformType)(0),      // 14: rssalchemy.TransformType
+	(*Action)(nil),          // 15: rssalchemy.Action
+	(*Filter)(nil),          // 16: rssalchemy.Filter
+	(*Transform)(nil),       // 17: rssalchemy.Transform
+	(*Specs)(nil),           // 18: rssalchemy.Specs
 }
 var file_proto_specs_proto_depIdxs = []int32{
-	0, // 0: rssalchemy.Specs.created_extract_from:type_name -> rssalchemy.ExtractFrom
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	10, // 0: rssalchemy.Action.type:type_name -> rssalchemy.ActionType
+	1,  // 1: rssalchemy.Action.selector_dialect:type_name -> rssalchemy.SelectorDialect
+	11, // 2: rssalchemy.Filter.field:type_name -> rssalchemy.FilterField
+	12, // 3: rssalchemy.Filter.match:type_name -> rssalchemy.FilterMatch
+	14, // 4: rssalchemy.Transform.type:type_name -> rssalchemy.TransformType
+	0,  // 5: rssalchemy.Specs.created_extract_from:type_name -> rssalchemy.ExtractFrom
+	2,  // 6: rssalchemy.Specs.format:type_name -> rssalchemy.FeedFormat
+	3,  // 7: rssalchemy.Specs.id_strategy:type_name -> rssalchemy.IdStrategy
+	4,  // 8: rssalchemy.Specs.fulltext_mode:type_name -> rssalchemy.FullTextMode
+	15, // 9: rssalchemy.Specs.actions:type_name -> rssalchemy.Action
+	16, // 10: rssalchemy.Specs.filters:type_name -> rssalchemy.Filter
+	13, // 11: rssalchemy.Specs.filter_mode:type_name -> rssalchemy.FilterMode
+	17, // 12: rssalchemy.Specs.transforms:type_name -> rssalchemy.Transform
+	0,  // 13: rssalchemy.Specs.title_extract_from:type_name -> rssalchemy.ExtractFrom
+	0,  // 14: rssalchemy.Specs.link_extract_from:type_name -> rssalchemy.ExtractFrom
+	0,  // 15: rssalchemy.Specs.description_extract_from:type_name -> rssalchemy.ExtractFrom
+	0,  // 16: rssalchemy.Specs.author_extract_from:type_name -> rssalchemy.ExtractFrom
+	0,  // 17: rssalchemy.Specs.enclosure_extract_from:type_name -> rssalchemy.ExtractFrom
+	0,  // 18: rssalchemy.Specs.updated_extract_from:type_name -> rssalchemy.ExtractFrom
+	1,  // 19: rssalchemy.Specs.selector_post_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 20: rssalchemy.Specs.selector_title_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 21: rssalchemy.Specs.selector_link_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 22: rssalchemy.Specs.selector_description_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 23: rssalchemy.Specs.selector_author_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 24: rssalchemy.Specs.selector_created_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 25: rssalchemy.Specs.selector_content_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 26: rssalchemy.Specs.selector_enclosure_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 27: rssalchemy.Specs.selector_id_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 28: rssalchemy.Specs.selector_fulltext_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 29: rssalchemy.Specs.selector_next_page_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 30: rssalchemy.Specs.selector_load_more_dialect:type_name -> rssalchemy.SelectorDialect
+	1,  // 31: rssalchemy.Specs.selector_updated_dialect:type_name -> rssalchemy.SelectorDialect
+	5,  // 32: rssalchemy.Specs.render_mode:type_name -> rssalchemy.RenderMode
+	6,  // 33: rssalchemy.Specs.source_type:type_name -> rssalchemy.SourceType
+	9,  // 34: rssalchemy.Specs.json_method:type_name -> rssalchemy.HttpMethod
+	8,  // 35: rssalchemy.Specs.json_query_language:type_name -> rssalchemy.JsonQueryLanguage
+	7,  // 36: rssalchemy.Specs.structured_data:type_name -> rssalchemy.StructuredDataMode
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_proto_specs_proto_init() }
@@ -319,8 +2335,8 @@ func file_proto_specs_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_specs_proto_rawDesc), len(file_proto_specs_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      15,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
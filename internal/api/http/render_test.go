@@ -0,0 +1,159 @@
+package http
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/egor3f/rssalchemy/internal/adapters"
+	"github.com/egor3f/rssalchemy/internal/api/http/pb"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/egor3f/rssalchemy/internal/sanitizer"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+)
+
+type testCache struct {
+	result []byte
+	ts     time.Time
+}
+
+func (c *testCache) Get(string) ([]byte, time.Time, error) {
+	if c.result == nil {
+		return nil, time.Time{}, adapters.ErrKeyNotFound
+	}
+	return c.result, c.ts, nil
+}
+
+func (c *testCache) Set(string, []byte) error {
+	return nil
+}
+
+type testQueue struct {
+	t *testing.T
+}
+
+func (q testQueue) Enqueue(context.Context, string, []byte) ([]byte, error) {
+	q.t.Fatal("cached result must be used")
+	return nil, nil
+}
+
+type testArchive struct {
+	items []adapters.ArchivedItem
+}
+
+func (a *testArchive) Merge(_ string, items []adapters.ArchivedItem, limits adapters.ArchiveLimits) ([]adapters.ArchivedItem, error) {
+	a.items = adapters.MergeItems(a.items, items, limits, time.Now())
+	return a.items, nil
+}
+
+func encodeTestSpecs(t *testing.T, specs *pb.Specs) string {
+	data, err := proto.Marshal(specs)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	require.NoError(t, err)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return "1:" + base64.StdEncoding.WithPadding(base64.NoPadding).EncodeToString(buf.Bytes())
+}
+
+func TestRenderNotModified(t *testing.T) {
+	resultBytes, err := json.Marshal(testResult)
+	require.NoError(t, err)
+	archive := &testArchive{}
+	h := New(
+		testQueue{t},
+		&testCache{result: resultBytes, ts: time.Now()},
+		archive,
+		sanitizer.New(sanitizer.DefaultPolicy()),
+		rate.Inf,
+		1,
+		false,
+	)
+	e := echo.New()
+	h.SetupRoutes(e.Group("/api/v1"))
+	path := "/api/v1/render/" + encodeTestSpecs(t, &pb.Specs{
+		Url:           "https://example.com/blog",
+		SelectorPost:  "article",
+		SelectorTitle: "h2",
+		SelectorLink:  "a",
+		CacheLifetime: "1h",
+		ArchiveItems:  10,
+	})
+	get := func(etag string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if len(etag) > 0 {
+			req.Header.Set("If-None-Match", etag)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := get("")
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	notModified := get(etag)
+	assert.Equal(t, http.StatusNotModified, notModified.Code)
+	assert.Empty(t, notModified.Body.String())
+
+	// another webserver archived item, cached result is the same, but feed is not
+	archive.items = append(archive.items, adapters.ArchivedItem{Id: "old", Item: models.FeedItem{
+		Title:   "Old post",
+		Link:    "https://example.com/blog/old",
+		Created: time.Now().Add(-time.Hour),
+	}})
+	changed := get(etag)
+	assert.Equal(t, http.StatusOK, changed.Code)
+	assert.NotEqual(t, etag, changed.Header().Get("ETag"))
+	assert.Contains(t, changed.Body.String(), "Old post")
+}
+
+func TestRenderNotModifiedEmpty(t *testing.T) {
+	resultBytes, err := json.Marshal(testResult)
+	require.NoError(t, err)
+	h := New(
+		testQueue{t},
+		&testCache{result: resultBytes, ts: time.Now().Add(-time.Minute)},
+		&testArchive{},
+		sanitizer.New(sanitizer.DefaultPolicy()),
+		rate.Inf,
+		1,
+		false,
+	)
+	e := echo.New()
+	h.SetupRoutes(e.Group("/api/v1"))
+	path := "/api/v1/render/" + encodeTestSpecs(t, &pb.Specs{
+		Url:           "https://example.com/blog",
+		SelectorPost:  "article",
+		SelectorTitle: "h2",
+		SelectorLink:  "a",
+		CacheLifetime: "1h",
+		Filters:       []*pb.Filter{{Pattern: "no such post"}},
+	})
+
+	first := httptest.NewRecorder()
+	e.ServeHTTP(first, httptest.NewRequest(http.MethodGet, path, nil))
+	require.Equal(t, http.StatusOK, first.Code)
+	require.Equal(t, "1/1", first.Header().Get("X-Filtered-Items"))
+
+	time.Sleep(time.Second) // feed dates have second precision
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("If-None-Match", first.Header().Get("ETag"))
+	second := httptest.NewRecorder()
+	e.ServeHTTP(second, req)
+	assert.Equal(t, http.StatusNotModified, second.Code)
+}
@@ -0,0 +1,315 @@
+package http
+
+import (
+	"cmp"
+	"encoding/xml"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/api/http/pb"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/gorilla/feeds"
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"html"
+	"math"
+	"mime"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// feed is a format-independent feed built by makeFeed.
+// Source items are kept alongside, so renderers can emit fields that feeds.Feed lacks
+type feed struct {
+	*feeds.Feed
+	icon     string
+	logo     string
+	language string
+	items    []models.FeedItem // parallel to Feed.Items
+	// plain text descriptions are escaped in Feed.Items, but not in items
+	htmlDescription bool
+}
+
+type FeedRenderer interface {
+	Render(f *feed) (string, error)
+	ContentType() string
+}
+
+var feedRenderers = map[pb.FeedFormat]FeedRenderer{
+	pb.FeedFormat_Atom:     atomRenderer{},
+	pb.FeedFormat_Rss:      rssRenderer{},
+	pb.FeedFormat_JsonFeed: jsonFeedRenderer{},
+}
+
+// feedFormats maps format query parameter to spec enum
+var feedFormats = map[string]pb.FeedFormat{
+	"atom": pb.FeedFormat_Atom,
+	"rss":  pb.FeedFormat_Rss,
+	"json": pb.FeedFormat_JsonFeed,
+}
+
+// acceptFormats maps Accept header media types to spec enum
+var acceptFormats = map[string]pb.FeedFormat{
+	"application/atom+xml":  pb.FeedFormat_Atom,
+	"application/rss+xml":   pb.FeedFormat_Rss,
+	"application/feed+json": pb.FeedFormat_JsonFeed,
+}
+
+type atomRenderer struct{}
+
+func (atomRenderer) ContentType() string {
+	return "application/atom+xml; charset=utf-8"
+}
+
+// atomFeedXml adds language attribute to feeds.AtomFeed
+type atomFeedXml struct {
+	*feeds.AtomFeed
+	Lang string `xml:"xml:lang,attr,omitempty"`
+}
+
+func (a *atomFeedXml) FeedXml() interface{} {
+	return a
+}
+
+func (atomRenderer) Render(f *feed) (string, error) {
+	atomFeed := (&feeds.Atom{Feed: f.Feed}).AtomFeed()
+	atomFeed.Icon = f.icon
+	atomFeed.Logo = f.logo
+	for i, entry := range atomFeed.Entries {
+		if entry.Author != nil {
+			entry.Author.Uri = f.items[i].AuthorLink
+		}
+	}
+	atom, err := feeds.ToXML(&atomFeedXml{AtomFeed: atomFeed, Lang: f.language})
+	if err != nil {
+		return "", fmt.Errorf("atom to xml: %w", err)
+	}
+	return atom, nil
+}
+
+type rssRenderer struct{}
+
+func (rssRenderer) ContentType() string {
+	return "application/rss+xml; charset=utf-8"
+}
+
+// rssFeedXml is like feeds.RssFeedXml, but with dublin core namespace
+// needed for author names (rss author element must be an email)
+type rssFeedXml struct {
+	XMLName          xml.Name `xml:"rss"`
+	Version          string   `xml:"version,attr"`
+	ContentNamespace string   `xml:"xmlns:content,attr"`
+	DcNamespace      string   `xml:"xmlns:dc,attr"`
+	Channel          *rssChannel
+}
+
+type rssChannel struct {
+	*feeds.RssFeed
+	Items []*rssItem `xml:"item"` // shadows RssFeed.Items
+}
+
+type rssItem struct {
+	*feeds.RssItem
+	Creator string `xml:"dc:creator,omitempty"`
+}
+
+func (r *rssFeedXml) FeedXml() interface{} {
+	return r
+}
+
+func (rssRenderer) Render(f *feed) (string, error) {
+	rssFeed := (&feeds.Rss{Feed: f.Feed}).RssFeed()
+	rssFeed.Language = f.language
+	if image := cmp.Or(f.icon, f.logo); len(image) > 0 {
+		rssFeed.Image = &feeds.RssImage{Url: image, Title: rssFeed.Title, Link: rssFeed.Link}
+	}
+	channel := &rssChannel{RssFeed: rssFeed}
+	for i, entry := range rssFeed.Items {
+		item := f.items[i]
+		entry.Author = ""
+		if entry.Guid != nil {
+			entry.Guid.IsPermaLink = "false"
+		}
+		if enclosure := makeEnclosure(item); enclosure != nil {
+			if len(enclosure.Length) == 0 {
+				enclosure.Length = "0" // unknown, but required by spec
+			}
+			entry.Enclosure = &feeds.RssEnclosure{
+				Url:    enclosure.Url,
+				Type:   enclosure.Type,
+				Length: enclosure.Length,
+			}
+		}
+		channel.Items = append(channel.Items, &rssItem{RssItem: entry, Creator: item.AuthorName})
+	}
+	rss, err := feeds.ToXML(&rssFeedXml{
+		Version:          "2.0",
+		ContentNamespace: "http://purl.org/rss/1.0/modules/content/",
+		DcNamespace:      "http://purl.org/dc/elements/1.1/",
+		Channel:          channel,
+	})
+	if err != nil {
+		return "", fmt.Errorf("rss to xml: %w", err)
+	}
+	return rss, nil
+}
+
+type jsonFeedRenderer struct{}
+
+func (jsonFeedRenderer) ContentType() string {
+	return "application/feed+json; charset=utf-8"
+}
+
+func (jsonFeedRenderer) Render(f *feed) (string, error) {
+	jsonFeed := (&feeds.JSON{Feed: f.Feed}).JSONFeed()
+	// json is not html, so titles must not be escaped
+	jsonFeed.Title = html.UnescapeString(jsonFeed.Title)
+	jsonFeed.Description = html.UnescapeString(jsonFeed.Description)
+	jsonFeed.Language = f.language
+	jsonFeed.Icon = f.logo
+	jsonFeed.Favicon = f.icon
+	for i, entry := range jsonFeed.Items {
+		item := f.items[i]
+		entry.Title = item.Title
+		// summary is plain text, and item must have html or text content
+		if f.htmlDescription {
+			entry.Summary = htmlText(item.Description)
+			if len(item.Content) == 0 {
+				entry.ContentHTML = item.Description
+			}
+		} else {
+			entry.Summary = item.Description
+			if len(item.Content) == 0 {
+				entry.ContentText = item.Description
+			}
+		}
+		if len(entry.ContentHTML) == 0 && len(entry.ContentText) == 0 {
+			entry.ContentText = item.Title
+		}
+		entry.Author = nil
+		entry.Authors = nil
+		if len(item.AuthorName) > 0 || len(item.AuthorLink) > 0 {
+			author := &feeds.JSONAuthor{Name: item.AuthorName, Url: item.AuthorLink}
+			entry.Author = author
+			entry.Authors = []*feeds.JSONAuthor{author}
+		}
+		if enclosure := makeEnclosure(item); enclosure != nil {
+			if strings.HasPrefix(enclosure.Type, "image/") {
+				entry.Image = enclosure.Url
+			} else {
+				entry.Attachments = append(entry.Attachments, feeds.JSONAttachment{
+					Url:      enclosure.Url,
+					MIMEType: enclosure.Type,
+					Size:     int32(min(item.EnclosureLength, math.MaxInt32)),
+				})
+			}
+		}
+	}
+	jsonStr, err := jsonFeed.ToJSON()
+	if err != nil {
+		return "", fmt.Errorf("json feed marshal: %w", err)
+	}
+	return jsonStr, nil
+}
+
+// htmlText is text of html fragment with collapsed whitespace
+func htmlText(fragment string) string {
+	if len(fragment) == 0 {
+		return ""
+	}
+	nodes, err := xhtml.ParseFragment(strings.NewReader(fragment), &xhtml.Node{
+		Type:     xhtml.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return ""
+	}
+	var sb strings.Builder
+	var walk func(n *xhtml.Node)
+	walk = func(n *xhtml.Node) {
+		if n.Type == xhtml.TextNode {
+			sb.WriteString(n.Data)
+			sb.WriteByte(' ')
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	for _, n := range nodes {
+		walk(n)
+	}
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// negotiateFormat returns format from Accept header if client strictly prefers it to specs format.
+// Ranges with q=0 are not acceptable. Wildcards give their quality to specs format unless it is listed itself
+func negotiateFormat(accept string, specsFormat pb.FeedFormat) (pb.FeedFormat, bool) {
+	quality := make(map[pb.FeedFormat]float64)
+	wildcard := -1.0
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if qParam, ok := params["q"]; ok {
+			q, err = strconv.ParseFloat(qParam, 64)
+			if err != nil || q < 0 || q > 1 {
+				continue
+			}
+		}
+		if mediaType == "*/*" || mediaType == "application/*" {
+			wildcard = max(wildcard, q)
+			continue
+		}
+		if format, ok := acceptFormats[mediaType]; ok {
+			quality[format] = max(quality[format], q)
+		}
+	}
+
+	specsQuality, listed := quality[specsFormat]
+	if !listed {
+		specsQuality = max(wildcard, 0)
+	}
+	best, bestQuality := specsFormat, specsQuality
+	for _, format := range []pb.FeedFormat{pb.FeedFormat_Atom, pb.FeedFormat_Rss, pb.FeedFormat_JsonFeed} {
+		if q := quality[format]; q > 0 && q > bestQuality {
+			best, bestQuality = format, q
+		}
+	}
+	return best, best != specsFormat
+}
+
+// makeEnclosure returns nil if item has no enclosure.
+// Type is guessed if worker could not determine it, Length is empty if unknown
+func makeEnclosure(item models.FeedItem) *feeds.Enclosure {
+	if len(item.Enclosure) == 0 {
+		return nil
+	}
+	enclosure := feeds.Enclosure{
+		Url:  item.Enclosure,
+		Type: item.EnclosureType,
+	}
+	if len(enclosure.Type) == 0 {
+		enclosure.Type = guessMimeType(item.Enclosure)
+	}
+	if item.EnclosureLength > 0 {
+		enclosure.Length = strconv.FormatInt(item.EnclosureLength, 10)
+	}
+	return &enclosure
+}
+
+// guessMimeType by file extension in url path
+func guessMimeType(rawUrl string) string {
+	const fallback = "application/octet-stream"
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return fallback
+	}
+	mimeType := mime.TypeByExtension(path.Ext(u.Path))
+	if len(mimeType) == 0 {
+		return fallback
+	}
+	return mimeType
+}
@@ -0,0 +1,251 @@
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/egor3f/rssalchemy/internal/api/http/pb"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTask = models.Task{
+	TaskType: models.TaskTypeExtract,
+	URL:      "https://example.com/blog",
+}
+
+var testResult = models.TaskResult{
+	Title: "Example blog",
+	Icon:  "https://example.com/icon.png",
+	Items: []models.FeedItem{
+		{
+			Title:       "First post",
+			Created:     time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC),
+			AuthorName:  "John",
+			AuthorLink:  "https://example.com/john",
+			Link:        "https://example.com/blog/first?page=1",
+			Description: "Short text",
+			Content:     "<p>Long text</p>",
+			Enclosure:   "https://example.com/cover.png",
+		},
+	},
+}
+
+func TestMakeFeed(t *testing.T) {
+	f, err := makeFeed(testTask, testResult, time.Now())
+	require.NoError(t, err)
+	require.Len(t, f.Items, 1)
+	require.Len(t, f.items, 1)
+	assert.Equal(t, "tag:example.com,2025-01-10:/blog/first?page=1", f.Items[0].Id)
+
+	resultTS := time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)
+	f, err = makeFeed(testTask, models.TaskResult{Title: "Empty"}, resultTS)
+	require.NoError(t, err)
+	assert.Empty(t, f.Items)
+	assert.Equal(t, resultTS, f.Updated, "feed without dates is updated when result is extracted")
+
+	_, err = makeFeed(testTask, models.TaskResult{Title: "Invalid", Items: []models.FeedItem{{Link: "http://[::1"}}}, time.Now())
+	assert.Error(t, err)
+}
+
+func TestAtomRenderer(t *testing.T) {
+	f, err := makeFeed(testTask, testResult, time.Now())
+	require.NoError(t, err)
+	atom, err := atomRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, atom, `<feed xmlns="http://www.w3.org/2005/Atom">`)
+	assert.Contains(t, atom, `<icon>https://example.com/icon.png</icon>`)
+	assert.Contains(t, atom, `<uri>https://example.com/john</uri>`)
+}
+
+func TestRssRenderer(t *testing.T) {
+	f, err := makeFeed(testTask, testResult, time.Now())
+	require.NoError(t, err)
+	rss, err := rssRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, rss, `<rss version="2.0"`)
+	assert.Contains(t, rss, `<guid isPermaLink="false">tag:example.com,2025-01-10:/blog/first?page=1</guid>`)
+	assert.Contains(t, rss, `<pubDate>Fri, 10 Jan 2025 10:00:00 +0000</pubDate>`)
+	assert.Contains(t, rss, `<dc:creator>John</dc:creator>`)
+	assert.Contains(t, rss, `<content:encoded><![CDATA[<p>Long text</p>]]></content:encoded>`)
+	assert.Contains(t, rss, `<enclosure url="https://example.com/cover.png" length="0" type="image/png"></enclosure>`)
+	assert.Contains(t, rss, `<url>https://example.com/icon.png</url>`)
+	assert.NotContains(t, rss, `<author>`)
+}
+
+func TestJsonFeedRenderer(t *testing.T) {
+	result := testResult
+	result.Title = "Tom & Jerry"
+	result.Image = "https://example.com/og.png"
+	f, err := makeFeed(testTask, result, time.Now())
+	require.NoError(t, err)
+	jsonFeed, err := jsonFeedRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, jsonFeed, `"version": "https://jsonfeed.org/version/1.1"`)
+	assert.Contains(t, jsonFeed, `"title": "Tom \u0026 Jerry"`)
+	assert.Contains(t, jsonFeed, `"icon": "https://example.com/og.png"`)
+	assert.Contains(t, jsonFeed, `"favicon": "https://example.com/icon.png"`)
+	assert.Contains(t, jsonFeed, `"content_html": "\u003cp\u003eLong text\u003c/p\u003e"`)
+	assert.Contains(t, jsonFeed, `"id": "tag:example.com,2025-01-10:/blog/first?page=1"`)
+	assert.Contains(t, jsonFeed, `"url": "https://example.com/john"`)
+	assert.Contains(t, jsonFeed, `"image": "https://example.com/cover.png"`)
+	assert.Contains(t, jsonFeed, `"date_published": "2025-01-10T10:00:00Z"`)
+}
+
+func TestJsonFeedWithoutContent(t *testing.T) {
+	result := testResult
+	result.Items = []models.FeedItem{testResult.Items[0], testResult.Items[0]}
+	result.Items[0].Content = ""
+	result.Items[1].Content = ""
+	result.Items[1].Description = ""
+	result.Items[1].Link = "https://example.com/blog/second"
+	f, err := makeFeed(testTask, result, time.Now())
+	require.NoError(t, err)
+	jsonFeed, err := jsonFeedRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, jsonFeed, `"content_text": "Short text"`)
+	assert.Contains(t, jsonFeed, `"content_text": "First post"`)
+	assert.NotContains(t, jsonFeed, `"content_html"`)
+
+	htmlTask := testTask
+	htmlTask.DescriptionExtractFrom = models.ExtractFrom_InnerHtml
+	result.Items = result.Items[:1]
+	result.Items[0].Description = "<p>Short <b>text</b></p>"
+	f, err = makeFeed(htmlTask, result, time.Now())
+	require.NoError(t, err)
+	jsonFeed, err = jsonFeedRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, jsonFeed, `"content_html": "\u003cp\u003eShort \u003cb\u003etext\u003c/b\u003e\u003c/p\u003e"`)
+	assert.Contains(t, jsonFeed, `"summary": "Short text"`)
+}
+
+func TestPlainTextDescription(t *testing.T) {
+	result := testResult
+	result.Items = []models.FeedItem{testResult.Items[0]}
+	result.Items[0].Description = "Use <b> for bold & <i> for italic"
+	f, err := makeFeed(testTask, result, time.Now())
+	require.NoError(t, err)
+
+	atom, err := atomRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, atom, `<summary type="html">Use &amp;lt;b&amp;gt; for bold &amp;amp; &amp;lt;i&amp;gt; for italic</summary>`)
+
+	jsonFeed, err := jsonFeedRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, jsonFeed, `"summary": "Use \u003cb\u003e for bold \u0026 \u003ci\u003e for italic"`)
+
+	htmlTask := testTask
+	htmlTask.DescriptionExtractFrom = models.ExtractFrom_InnerHtml
+	result.Items[0].Description = "<b>bold</b>"
+	f, err = makeFeed(htmlTask, result, time.Now())
+	require.NoError(t, err)
+	atom, err = atomRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, atom, `<summary type="html">&lt;b&gt;bold&lt;/b&gt;</summary>`)
+}
+
+func TestNegotiateFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		accept   string
+		specs    pb.FeedFormat
+		expected pb.FeedFormat
+		ok       bool
+	}{
+		{"specs format preferred", "application/atom+xml, application/feed+json;q=0.9", pb.FeedFormat_Atom, pb.FeedFormat_Atom, false},
+		{"other format preferred", "application/atom+xml;q=0.5, application/feed+json", pb.FeedFormat_Atom, pb.FeedFormat_JsonFeed, true},
+		{"equal quality keeps specs", "application/rss+xml, application/feed+json", pb.FeedFormat_JsonFeed, pb.FeedFormat_JsonFeed, false},
+		{"only other format", "application/rss+xml", pb.FeedFormat_Atom, pb.FeedFormat_Rss, true},
+		{"q=0 is not acceptable", "application/feed+json;q=0", pb.FeedFormat_Atom, pb.FeedFormat_Atom, false},
+		{"wildcard counts for specs", "application/feed+json;q=0.8, */*", pb.FeedFormat_Rss, pb.FeedFormat_Rss, false},
+		{"listed specs format wins over wildcard", "*/*, application/rss+xml;q=0.1, application/feed+json;q=0.5", pb.FeedFormat_Rss, pb.FeedFormat_JsonFeed, true},
+		{"invalid q is ignored", "application/feed+json;q=abc", pb.FeedFormat_Atom, pb.FeedFormat_Atom, false},
+		{"unknown types", "text/html,*/*", pb.FeedFormat_Atom, pb.FeedFormat_Atom, false},
+		{"empty", "", pb.FeedFormat_Rss, pb.FeedFormat_Rss, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, ok := negotiateFormat(tt.accept, tt.specs)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, format)
+		})
+	}
+}
+
+func TestEnclosure(t *testing.T) {
+	result := testResult
+	result.Items = []models.FeedItem{testResult.Items[0]}
+	result.Items[0].Enclosure = "https://example.com/episode1"
+	result.Items[0].EnclosureType = "audio/mpeg"
+	result.Items[0].EnclosureLength = 123456
+	f, err := makeFeed(testTask, result, time.Now())
+	require.NoError(t, err)
+
+	atom, err := atomRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, atom, `<link href="https://example.com/episode1" rel="enclosure" type="audio/mpeg" length="123456"></link>`)
+
+	rss, err := rssRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, rss, `<enclosure url="https://example.com/episode1" length="123456" type="audio/mpeg"></enclosure>`)
+
+	jsonFeed, err := jsonFeedRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, jsonFeed, `"mime_type": "audio/mpeg"`)
+	assert.Contains(t, jsonFeed, `"size": 123456`)
+}
+
+func TestFeedMetadata(t *testing.T) {
+	result := testResult
+	result.Description = "All about examples"
+	result.Language = "en"
+	result.Image = "https://example.com/og.png"
+	f, err := makeFeed(testTask, result, time.Now())
+	require.NoError(t, err)
+
+	atom, err := atomRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, atom, `<feed xmlns="http://www.w3.org/2005/Atom" xml:lang="en">`)
+	assert.Contains(t, atom, `<subtitle>All about examples</subtitle>`)
+	assert.Contains(t, atom, `<logo>https://example.com/og.png</logo>`)
+
+	rss, err := rssRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, rss, `<description>All about examples</description>`)
+	assert.Contains(t, rss, `<language>en</language>`)
+
+	jsonFeed, err := jsonFeedRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, jsonFeed, `"description": "All about examples"`)
+	assert.Contains(t, jsonFeed, `"language": "en"`)
+
+	task := testTask
+	task.FeedTitle = "My title"
+	task.FeedSubtitle = "My subtitle"
+	f, err = makeFeed(task, result, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "My title", f.Title)
+	assert.Equal(t, "My subtitle", f.Description)
+}
+
+func TestFeedUpdated(t *testing.T) {
+	created := time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC)
+	updated := time.Date(2025, 1, 12, 8, 30, 0, 0, time.UTC)
+	result := models.TaskResult{Items: []models.FeedItem{
+		{Title: "Old thread", Link: "https://example.com/t/1", Created: created, Updated: updated},
+		{Title: "New thread", Link: "https://example.com/t/2", Created: created.Add(time.Hour)},
+	}}
+	f, err := makeFeed(testTask, result, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, updated, f.Updated, "feed updated is the latest item change")
+	assert.Equal(t, updated, f.Items[0].Updated)
+
+	atom, err := atomRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, atom, "<updated>2025-01-12T08:30:00Z</updated>")
+
+	jsonFeed, err := jsonFeedRenderer{}.Render(f)
+	require.NoError(t, err)
+	assert.Contains(t, jsonFeed, `"date_modified": "2025-01-12T08:30:00Z"`)
+}
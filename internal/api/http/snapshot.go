@@ -0,0 +1,64 @@
+package http
+
+import (
+	"encoding/base64"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// snapshotResponse is screenshot with elements for visual picker in wizard
+type snapshotResponse struct {
+	Image    string            `json:"image"` // png data url
+	Width    int               `json:"width"`
+	Height   int               `json:"height"`
+	Elements []snapshotElement `json:"elements"`
+}
+
+type snapshotElement struct {
+	Tag             string   `json:"tag"`
+	Id              string   `json:"id,omitempty"`
+	Classes         []string `json:"classes"`
+	Text            string   `json:"text"`
+	X               int      `json:"x"`
+	Y               int      `json:"y"`
+	Width           int      `json:"width"`
+	Height          int      `json:"height"`
+	Selector        string   `json:"selector"`
+	GeneralSelector string   `json:"general_selector"`
+}
+
+func (h *Handler) handlePageSnapshot(c echo.Context) error {
+	var result models.SnapshotTaskResult
+	if err := h.runPageTask(c, models.TaskTypePageSnapshot, &result); err != nil {
+		return err
+	}
+	return c.JSON(200, makeSnapshotResponse(result))
+}
+
+func makeSnapshotResponse(result models.SnapshotTaskResult) snapshotResponse {
+	resp := snapshotResponse{
+		Image:    "data:image/png;base64," + base64.StdEncoding.EncodeToString(result.Image),
+		Width:    result.Width,
+		Height:   result.Height,
+		Elements: make([]snapshotElement, len(result.Elements)),
+	}
+	for i, el := range result.Elements {
+		classes := el.Classes
+		if classes == nil {
+			classes = []string{}
+		}
+		resp.Elements[i] = snapshotElement{
+			Tag:             el.Tag,
+			Id:              el.Id,
+			Classes:         classes,
+			Text:            el.Text,
+			X:               el.X,
+			Y:               el.Y,
+			Width:           el.Width,
+			Height:          el.Height,
+			Selector:        el.Selector,
+			GeneralSelector: el.GeneralSelector,
+		}
+	}
+	return resp
+}
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeSnapshotResponse(t *testing.T) {
+	resp := makeSnapshotResponse(models.SnapshotTaskResult{
+		Image:  []byte{0x89, 'P', 'N', 'G'},
+		Width:  1280,
+		Height: 5000,
+		Elements: []models.SnapshotElement{{
+			Tag:             "article",
+			Text:            "Post",
+			X:               10,
+			Y:               20,
+			Width:           300,
+			Height:          100,
+			Selector:        "main > article:nth-of-type(1)",
+			GeneralSelector: "main > article",
+		}},
+	})
+	assert.Equal(t, "data:image/png;base64,iVBORw==", resp.Image)
+
+	encoded, err := json.Marshal(resp)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, map[string]any{
+		"tag":              "article",
+		"classes":          []any{},
+		"text":             "Post",
+		"x":                float64(10),
+		"y":                float64(20),
+		"width":            float64(300),
+		"height":           float64(100),
+		"selector":         "main > article:nth-of-type(1)",
+		"general_selector": "main > article",
+	}, decoded["elements"].([]any)[0])
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"github.com/egor3f/rssalchemy/internal/api/http/pb"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/echo/v4"
+	"time"
+)
+
+// suggestion is candidate selector set for wizard. Specs contain only url and selectors, other fields are defaults
+type suggestion struct {
+	Score   float64       `json:"score"`
+	Specs   *pb.Specs     `json:"specs"`
+	Preview []previewItem `json:"preview"`
+}
+
+type previewItem struct {
+	Title       string    `json:"title"`
+	Link        string    `json:"link"`
+	Description string    `json:"description,omitempty"`
+	Created     time.Time `json:"created"`
+	Enclosure   string    `json:"enclosure,omitempty"`
+}
+
+// handleSuggestSelectors runs selector heuristics on page in worker and returns ranked suggestions
+func (h *Handler) handleSuggestSelectors(c echo.Context) error {
+	var result models.SuggestTaskResult
+	if err := h.runPageTask(c, models.TaskTypeSuggest, &result); err != nil {
+		return err
+	}
+	return c.JSON(200, makeSuggestions(c.QueryParam("url"), result))
+}
+
+func makeSuggestions(pageUrl string, result models.SuggestTaskResult) []suggestion {
+	suggestions := make([]suggestion, 0, len(result.Suggestions))
+	for _, s := range result.Suggestions {
+		specs := &pb.Specs{
+			Url:                 pageUrl,
+			SelectorPost:        s.SelectorPost,
+			SelectorTitle:       s.SelectorTitle,
+			SelectorLink:        s.SelectorLink,
+			SelectorDescription: s.SelectorDescription,
+			SelectorCreated:     s.SelectorCreated,
+			SelectorEnclosure:   s.SelectorEnclosure,
+		}
+		if len(s.CreatedAttributeName) > 0 {
+			specs.CreatedExtractFrom = pb.ExtractFrom_Attribute
+			specs.CreatedAttributeName = s.CreatedAttributeName
+		}
+		preview := make([]previewItem, len(s.Preview))
+		for i, item := range s.Preview {
+			preview[i] = previewItem{
+				Title:       item.Title,
+				Link:        item.Link,
+				Description: item.Description,
+				Created:     item.Created,
+				Enclosure:   item.Enclosure,
+			}
+		}
+		suggestions = append(suggestions, suggestion{Score: s.Score, Specs: specs, Preview: preview})
+	}
+	return suggestions
+}
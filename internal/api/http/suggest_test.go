@@ -0,0 +1,47 @@
+package http
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeSuggestions(t *testing.T) {
+	created := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	suggestions := makeSuggestions("https://example.com/blog", models.SuggestTaskResult{
+		Suggestions: []models.SelectorSuggestion{{
+			Score:                3.5,
+			SelectorPost:         "article.post",
+			SelectorTitle:        "h2",
+			SelectorLink:         "h2 a",
+			SelectorCreated:      "time",
+			CreatedAttributeName: "datetime",
+			Preview:              []models.FeedItem{{Title: "Post", Link: "https://example.com/p/1", Created: created}},
+		}},
+	})
+	require.Len(t, suggestions, 1)
+
+	encoded, err := json.Marshal(suggestions[0])
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+
+	specs := decoded["specs"].(map[string]any)
+	assert.Equal(t, "https://example.com/blog", specs["url"])
+	assert.Equal(t, "article.post", specs["selector_post"])
+	assert.Equal(t, "h2 a", specs["selector_link"])
+	assert.Equal(t, float64(1), specs["created_extract_from"], "attribute")
+	assert.Equal(t, "datetime", specs["created_attribute_name"])
+	assert.Equal(t, 3.5, decoded["score"])
+	assert.Equal(t, []any{map[string]any{
+		"title":   "Post",
+		"link":    "https://example.com/p/1",
+		"created": "2025-01-02T10:00:00Z",
+	}}, decoded["preview"])
+
+	assert.NotNil(t, makeSuggestions("https://example.com", models.SuggestTaskResult{}), "empty list, not null")
+}
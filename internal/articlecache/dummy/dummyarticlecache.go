@@ -0,0 +1,42 @@
+package dummy
+
+import (
+	"sync"
+	"time"
+)
+
+// Empty content means article body was not found, it is fetched again after this time
+const emptyTTL = time.Hour
+
+type article struct {
+	content string
+	created time.Time
+}
+
+// Cache keeps articles in memory, for development purposes
+type Cache struct {
+	mu       sync.Mutex
+	articles map[string]article
+}
+
+func New() *Cache {
+	c := Cache{articles: make(map[string]article)}
+	return &c
+}
+
+func (c *Cache) Get(key string) (string, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	a, ok := c.articles[key]
+	if ok && len(a.content) == 0 && time.Since(a.created) > emptyTTL {
+		return "", false, nil
+	}
+	return a.content, ok, nil
+}
+
+func (c *Cache) Set(key string, content string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.articles[key] = article{content: content, created: time.Now()}
+	return nil
+}
@@ -0,0 +1,61 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"time"
+)
+
+const (
+	// Articles are fetched again after this time, in case they were edited
+	cacheTTL = 7 * 24 * time.Hour
+	// Empty content means article body was not found, it may be a temporary failure
+	emptyTTL = time.Hour
+)
+
+type Cache struct {
+	kv jetstream.KeyValue
+}
+
+func New(natsc *nats.Conn) (*Cache, error) {
+	c := Cache{}
+
+	jets, err := jetstream.New(natsc)
+	if err != nil {
+		return nil, fmt.Errorf("create jetstream: %w", err)
+	}
+
+	c.kv, err = jets.CreateKeyValue(context.TODO(), jetstream.KeyValueConfig{
+		Bucket: "article_cache",
+		TTL:    cacheTTL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create nats kv: %w", err)
+	}
+
+	return &c, nil
+}
+
+func (c *Cache) Get(key string) (string, bool, error) {
+	entry, err := c.kv.Get(context.TODO(), key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("kv get: %w", err)
+	}
+	if len(entry.Value()) == 0 && time.Since(entry.Created()) > emptyTTL {
+		return "", false, nil
+	}
+	return string(entry.Value()), true, nil
+}
+
+func (c *Cache) Set(key string, content string) error {
+	if _, err := c.kv.Put(context.TODO(), key, []byte(content)); err != nil {
+		return fmt.Errorf("kv put: %w", err)
+	}
+	return nil
+}
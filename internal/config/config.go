@@ -30,6 +30,10 @@ type Config struct {
 	// IP ranges of reverse proxies for correct real ip detection (cidr format, sep. by comma)
 	TrustedIpRanges []string `env:"TRUSTED_IP_RANGES" env-default:"" validate:"omitempty,dive,cidr"`
 	RealIpHeader    string   `env:"REAL_IP_HEADER" env-default:"" validate:"omitempty"`
+	// Item html sanitizer: tags and attributes allowed in addition to default allowlist
+	// (format: tag or tag.attribute, sep. by comma, e.g. video,video.src) and tags removed from it
+	SanitizerAllow []string `env:"SANITIZER_ALLOW" env-default:"" validate:"omitempty,dive,required"`
+	SanitizerDeny  []string `env:"SANITIZER_DENY" env-default:"" validate:"omitempty,dive,required"`
 }
 
 func Read() (Config, error) {
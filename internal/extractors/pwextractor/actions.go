@@ -0,0 +1,63 @@
+package pwextractor
+
+import (
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/gommon/log"
+	"github.com/playwright-community/playwright-go"
+	"time"
+)
+
+// runActions performs task actions in order, stops at first failed non-optional action.
+// Actions time is limited by models.MaxActionsTime and task deadline
+func runActions(page playwright.Page, actions []models.Action, taskDeadline time.Time) error {
+	deadline := budgetDeadline(models.MaxActionsTime, taskDeadline)
+	for i, action := range actions {
+		start := time.Now()
+		err := runAction(page, action, deadline)
+		if err != nil {
+			err = fmt.Errorf("step %d (%s %q): %w", i+1, action.Type, action.Selector, err)
+			if !action.Optional {
+				return err
+			}
+			log.Infof("Optional action failed: %v", err)
+			continue
+		}
+		log.Debugf("Action %d (%s %q) done in %v", i+1, action.Type, action.Selector, time.Since(start))
+	}
+	return nil
+}
+
+func runAction(page playwright.Page, action models.Action, deadline time.Time) error {
+	timeout := min(action.MaxTime(), time.Until(deadline))
+	if timeout <= 0 {
+		return fmt.Errorf("time budget exceeded")
+	}
+	pwTimeout := pwDuration(timeout.String())
+	target := page.Locator(action.Selector).First()
+
+	switch action.Type {
+	case models.ActionType_Click:
+		return target.Click(playwright.LocatorClickOptions{Timeout: pwTimeout})
+	case models.ActionType_Fill:
+		return target.Fill(action.Value, playwright.LocatorFillOptions{Timeout: pwTimeout})
+	case models.ActionType_Press:
+		if len(action.Selector) == 0 {
+			return page.Keyboard().Press(action.Value)
+		}
+		return target.Press(action.Value, playwright.LocatorPressOptions{Timeout: pwTimeout})
+	case models.ActionType_WaitForSelector:
+		return target.WaitFor(playwright.LocatorWaitForOptions{Timeout: pwTimeout})
+	case models.ActionType_WaitForTimeout:
+		page.WaitForTimeout(float64(timeout.Milliseconds()))
+		return nil
+	case models.ActionType_SelectOption:
+		_, err := target.SelectOption(
+			playwright.SelectOptionValues{Values: &[]string{action.Value}},
+			playwright.LocatorSelectOptionOptions{Timeout: pwTimeout},
+		)
+		return err
+	default:
+		return fmt.Errorf("unknown action type %d", action.Type)
+	}
+}
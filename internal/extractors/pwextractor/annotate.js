@@ -0,0 +1,31 @@
+// let fnc = // for autocomplete
+(el, {label, color}) => {
+    const rect = el.getBoundingClientRect();
+    const box = document.createElement('div');
+    box.setAttribute('data-rssalchemy-debug', '');
+    Object.assign(box.style, {
+        position: 'absolute',
+        left: `${rect.left + window.scrollX}px`,
+        top: `${rect.top + window.scrollY}px`,
+        width: `${rect.width}px`,
+        height: `${rect.height}px`,
+        boxSizing: 'border-box',
+        border: `2px solid ${color}`,
+        zIndex: '2147483647',
+        pointerEvents: 'none',
+    });
+    const tag = document.createElement('span');
+    tag.textContent = label;
+    Object.assign(tag.style, {
+        position: 'absolute',
+        left: '-2px',
+        top: '-16px',
+        padding: '0 3px',
+        background: color,
+        color: '#ffffff',
+        font: '11px/14px monospace',
+        whiteSpace: 'nowrap',
+    });
+    box.appendChild(tag);
+    document.body.appendChild(box);
+}
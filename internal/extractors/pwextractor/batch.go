@@ -0,0 +1,104 @@
+package pwextractor
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"github.com/ericchiang/css"
+	"strings"
+)
+
+//go:embed extract_batch.js
+var extractBatchTemplate string
+
+// extractBatchScript has extract_post.js inlined, because page CSP may forbid eval
+var extractBatchScript = fmt.Sprintf(
+	"args => {\nconst extractContent = %s;\nreturn (%s)(args);\n}",
+	extractPostScript,
+	extractBatchTemplate,
+)
+
+// batchSelector is a selector which browser can run without playwright
+type batchSelector struct {
+	Value string `json:"value"`
+	XPath bool   `json:"xpath"`
+}
+
+type batchField struct {
+	Name        string        `json:"name"`
+	Selector    batchSelector `json:"selector"`
+	ExtractFrom int           `json:"extract_from"`
+	Attribute   string        `json:"attribute"`
+	Content     bool          `json:"content"`
+}
+
+// toBatchSelector returns false for playwright-only selectors (text=, :has-text() and so on).
+// Css is checked by strict parser, so anything unusual goes to playwright too
+func toBatchSelector(selector string) (batchSelector, bool) {
+	if xpath, ok := strings.CutPrefix(selector, "xpath="); ok {
+		return batchSelector{Value: xpath, XPath: true}, true
+	}
+	if strings.Contains(selector, ">>") {
+		return batchSelector{}, false
+	}
+	if _, err := css.Parse(selector); err != nil {
+		return batchSelector{}, false
+	}
+	return batchSelector{Value: selector}, true
+}
+
+// extractBatch reads all fields of all posts in one page.Evaluate call.
+// Unlike playwright, it does not pierce shadow dom, so empty result is an error and locators are used then
+func (p *pageParser) extractBatch(fields []postField) ([]rawPost, error) {
+	post, ok := toBatchSelector(p.task.SelectorPost)
+	if !ok {
+		return nil, fmt.Errorf("post selector %q is not supported", p.task.SelectorPost)
+	}
+	batchFields := make([]batchField, 0, len(fields))
+	for _, field := range fields {
+		selector, ok := toBatchSelector(field.Selector)
+		if !ok {
+			return nil, fmt.Errorf("%s selector %q is not supported", field.Name, field.Selector)
+		}
+		batchFields = append(batchFields, batchField{
+			Name:        field.Name,
+			Selector:    selector,
+			ExtractFrom: int(field.ExtractFrom),
+			Attribute:   field.Attribute,
+			Content:     field.Content,
+		})
+	}
+
+	// structs are passed to page as json objects
+	args, err := toJsonObject(map[string]any{"post": post, "fields": batchFields})
+	if err != nil {
+		return nil, fmt.Errorf("batch args: %w", err)
+	}
+	result, err := p.page.Evaluate(extractBatchScript, args)
+	if err != nil {
+		return nil, fmt.Errorf("evaluate: %w", err)
+	}
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("marshal result: %w", err)
+	}
+	var posts []rawPost
+	if err := json.Unmarshal(resultJson, &posts); err != nil {
+		return nil, fmt.Errorf("unmarshal result: %w", err)
+	}
+	if len(posts) == 0 {
+		return nil, fmt.Errorf("no posts found")
+	}
+	return posts, nil
+}
+
+func toJsonObject(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj any
+	err = json.Unmarshal(data, &obj)
+	return obj, err
+}
@@ -0,0 +1,109 @@
+package pwextractor
+
+import (
+	"os"
+	"testing"
+
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_toBatchSelector(t *testing.T) {
+	tests := []struct {
+		selector string
+		expected batchSelector
+		ok       bool
+	}{
+		{"article.post > h2 a[href]", batchSelector{Value: "article.post > h2 a[href]"}, true},
+		{"xpath=//h2/following-sibling::p", batchSelector{Value: "//h2/following-sibling::p", XPath: true}, true},
+		{"article:has-text('News')", batchSelector{}, false},
+		{"article >> nth=0", batchSelector{}, false},
+		{"text=Read more", batchSelector{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			selector, ok := toBatchSelector(tt.selector)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, selector)
+		})
+	}
+}
+
+var fixtureTask = models.Task{
+	SelectorPost:           "article.post",
+	SelectorTitle:          "h2.title",
+	SelectorLink:           "h2 a",
+	SelectorDescription:    "p.summary",
+	SelectorAuthor:         "a.author",
+	SelectorContent:        ".content",
+	SelectorEnclosure:      "img.cover",
+	EnclosureExtractFrom:   models.ExtractFrom_Attribute,
+	EnclosureAttributeName: "data-src",
+	SelectorId:             ".hidden",
+	SelectorCreated:        "xpath=//time",
+	CreatedExtractFrom:     models.ExtractFrom_Attribute,
+	CreatedAttributeName:   "datetime",
+}
+
+// newFixtureParser opens testdata/posts.html, test is skipped if playwright is not installed
+func newFixtureParser(tb testing.TB) *pageParser {
+	pw, err := playwright.Run()
+	if err != nil {
+		tb.Skipf("playwright is not available: %v", err)
+	}
+	tb.Cleanup(func() { _ = pw.Stop() })
+	browser, err := pw.Chromium.Launch()
+	if err != nil {
+		tb.Skipf("chromium is not available: %v", err)
+	}
+	tb.Cleanup(func() { _ = browser.Close() })
+	page, err := browser.NewPage()
+	require.NoError(tb, err)
+	html, err := os.ReadFile("testdata/posts.html")
+	require.NoError(tb, err)
+	require.NoError(tb, page.SetContent(string(html)))
+	return &pageParser{itemMaker: itemMaker{task: fixtureTask}, page: page}
+}
+
+func TestBatchMatchesLocators(t *testing.T) {
+	p := newFixtureParser(t)
+	fields, err := p.postFields()
+	require.NoError(t, err)
+
+	batch, err := p.extractBatch(fields)
+	require.NoError(t, err)
+	byLocators, err := p.extractByLocators(fields)
+	require.NoError(t, err)
+
+	require.Len(t, batch, 60)
+	assert.Equal(t, byLocators, batch)
+	assert.Equal(t, "/covers/1.jpg", batch[0][fieldEnclosure])
+	assert.Equal(t, "2025-01-02T10:00:00Z", batch[0][fieldCreated])
+	assert.Empty(t, batch[0][fieldId], "hidden elements are empty")
+}
+
+func BenchmarkExtractBatch(b *testing.B) {
+	p := newFixtureParser(b)
+	fields, err := p.postFields()
+	require.NoError(b, err)
+	b.ResetTimer()
+	for range b.N {
+		if _, err := p.extractBatch(fields); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkExtractByLocators(b *testing.B) {
+	p := newFixtureParser(b)
+	fields, err := p.postFields()
+	require.NoError(b, err)
+	b.ResetTimer()
+	for range b.N {
+		if _, err := p.extractByLocators(fields); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package pwextractor
+
+import (
+	_ "embed"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/gommon/log"
+	"github.com/playwright-community/playwright-go"
+	"time"
+)
+
+//go:embed annotate.js
+var annotateScript string
+
+const (
+	maxAnnotatedPosts = 50 // only first posts are outlined, otherwise labels cover the whole screenshot
+	postColor         = "#e00000"
+	pageColor         = "#7000c0"
+)
+
+var (
+	fieldColors   = []string{"#0060e0", "#00a000", "#e07000", "#00a0a0", "#c000a0", "#806000", "#404040"}
+	pageSelectors = []string{"next_page", "load_more"}
+)
+
+// DebugScreenshot loads page like Extract does and makes screenshot with outlines of post and field matches.
+// Posts are labeled with index from 1, fields with post index and field name. Selectors report has match counts
+func (e *PwExtractor) DebugScreenshot(task models.Task) (result *models.DebugTaskResult, errRet error) {
+	maker, err := e.newItemMaker(task)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(taskBudget)
+	loadMoreDeadline := budgetDeadline(loadMoreBudget, deadline)
+	errRet = e.visitPage(task, deadline, func(page playwright.Page) error {
+		parser := pageParser{
+			itemMaker: maker,
+			page:      page,
+			deadline:  loadMoreDeadline,
+		}
+		parser.waitFullLoad()
+		if task.ScrollSteps > 0 && parser.useSelectors() {
+			parser.loadMore()
+		}
+		// items are parsed before annotation, so labels do not get into them
+		items, err := parser.parseItems()
+		if err != nil {
+			log.Debugf("Debug: parse items: %v", err)
+		}
+		if err := prepareScreenshot(page); err != nil {
+			return err
+		}
+		selectors, err := parser.annotate()
+		if err != nil {
+			return err
+		}
+		screenshot, err := captureScreenshot(page)
+		if err != nil {
+			return err
+		}
+		result = &models.DebugTaskResult{Image: screenshot, Selectors: selectors, Items: items}
+		return nil
+	})
+	return
+}
+
+// annotate outlines matches of task selectors on page and counts them
+func (p *pageParser) annotate() ([]models.SelectorReport, error) {
+	fields, err := p.postFields()
+	if err != nil {
+		return nil, err
+	}
+	var reports []models.SelectorReport
+	if len(p.task.SelectorPost) > 0 {
+		reports = append(reports, p.annotatePosts(fields)...)
+	}
+	for i, selector := range []string{p.task.SelectorNextPage, p.task.SelectorLoadMore} {
+		if len(selector) == 0 {
+			continue
+		}
+		report := models.SelectorReport{Field: pageSelectors[i], Selector: selector}
+		matches, err := p.page.Locator(selector).All()
+		if err != nil {
+			report.Error = err.Error()
+		} else if report.Matches = len(matches); len(matches) > 0 {
+			p.outline(matches[0], pageSelectors[i], pageColor)
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (p *pageParser) annotatePosts(fields []postField) []models.SelectorReport {
+	postReport := models.SelectorReport{Field: "post", Selector: p.task.SelectorPost}
+	posts, err := p.page.Locator(p.task.SelectorPost).All()
+	if err != nil {
+		postReport.Error = err.Error()
+		return []models.SelectorReport{postReport}
+	}
+	postReport.Matches = len(posts)
+
+	var fieldReports []models.SelectorReport
+	for _, field := range fields {
+		if field.Name != fieldAuthorLink { // it uses author selector
+			fieldReports = append(fieldReports, models.SelectorReport{Field: field.Name, Selector: field.Selector})
+		}
+	}
+	for i, post := range posts {
+		annotated := i < maxAnnotatedPosts
+		if annotated {
+			p.outline(post, fmt.Sprintf("post %d", i+1), postColor)
+		}
+		for j := range fieldReports {
+			report := &fieldReports[j]
+			matches, err := post.Locator(report.Selector).All()
+			if err != nil {
+				report.Error = err.Error()
+				continue
+			}
+			report.Matches += len(matches)
+			if len(matches) == 0 {
+				continue
+			}
+			report.Posts++
+			if annotated {
+				p.outline(matches[0], fmt.Sprintf("%d.%s", i+1, report.Field), fieldColors[j%len(fieldColors)])
+			}
+		}
+	}
+	return append([]models.SelectorReport{postReport}, fieldReports...)
+}
+
+// outline draws labeled box over element, boxes are in document, so they scroll with page
+func (p *pageParser) outline(el playwright.Locator, label string, color string) {
+	_, err := el.Evaluate(
+		annotateScript,
+		map[string]any{"label": label, "color": color},
+		playwright.LocatorEvaluateOptions{Timeout: pwDuration(defTimeout)},
+	)
+	if err != nil {
+		log.Debugf("Outline %s: %v", label, err)
+	}
+}
@@ -0,0 +1,30 @@
+package pwextractor
+
+import (
+	"testing"
+
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnotate(t *testing.T) {
+	p := newFixtureParser(t)
+	p.task.SelectorNextPage = "a.next-page-missing"
+	reports, err := p.annotate()
+	require.NoError(t, err)
+
+	byField := make(map[string]models.SelectorReport)
+	for _, report := range reports {
+		byField[report.Field] = report
+	}
+	assert.Equal(t, 60, byField["post"].Matches)
+	assert.Equal(t, 60, byField[fieldTitle].Posts)
+	assert.Equal(t, 60, byField[fieldCreated].Matches)
+	assert.Equal(t, 0, byField["next_page"].Matches)
+	assert.NotContains(t, byField, fieldAuthorLink)
+
+	boxes, err := p.page.Locator("[data-rssalchemy-debug]").Count()
+	require.NoError(t, err)
+	assert.Greater(t, boxes, maxAnnotatedPosts, "posts and fields are outlined")
+}
@@ -0,0 +1,112 @@
+package pwextractor
+
+import (
+	"context"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/labstack/gommon/log"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+// Enclosure HEAD requests are limited by time per task, remaining enclosures get type guessed by extension
+var (
+	enclosureHeadTimeout = 3 * time.Second
+	enclosureTotalBudget = 10 * time.Second
+)
+
+type enclosureInfo struct {
+	mimeType string
+	length   int64
+}
+
+var enclosureCache *ttlcache.Cache[string, enclosureInfo]
+
+func init() {
+	enclosureCache = ttlcache.New[string, enclosureInfo](
+		ttlcache.WithTTL[string, enclosureInfo](24*time.Hour),
+		ttlcache.WithDisableTouchOnHit[string, enclosureInfo](),
+	)
+	go enclosureCache.Start()
+}
+
+// resolveEnclosures fills enclosure MIME type and length
+func (e *PwExtractor) resolveEnclosures(items []models.FeedItem, taskDeadline time.Time) {
+	deadline := budgetDeadline(enclosureTotalBudget, taskDeadline)
+	for i := range items {
+		item := &items[i]
+		if len(item.Enclosure) == 0 {
+			continue
+		}
+		info := enclosureInfo{mimeType: mimeTypeByExtension(item.Enclosure)}
+		if cached := enclosureCache.Get(item.Enclosure); cached != nil {
+			info = cached.Value()
+		} else if time.Now().Before(deadline) {
+			// limiter error means time budget is over, guessed info is not cached then
+			if err := e.waitLimiter(item.Enclosure, deadline); err != nil {
+				log.Infof("enclosure head %s: %v", item.Enclosure, err)
+			} else {
+				info = e.resolveEnclosure(item.Enclosure, info, deadline)
+			}
+		}
+		item.EnclosureType = info.mimeType
+		item.EnclosureLength = info.length
+	}
+}
+
+// resolveEnclosure updates guessed info with HEAD response and caches it. Failed requests are cached too
+func (e *PwExtractor) resolveEnclosure(enclosureUrl string, info enclosureInfo, deadline time.Time) enclosureInfo {
+	headInfo, err := e.headEnclosure(enclosureUrl, deadline)
+	if err != nil {
+		log.Warnf("enclosure head %s: %v", enclosureUrl, err)
+	} else {
+		if len(headInfo.mimeType) > 0 && headInfo.mimeType != "application/octet-stream" {
+			info.mimeType = headInfo.mimeType
+		}
+		info.length = headInfo.length
+	}
+	enclosureCache.Set(enclosureUrl, info, ttlcache.DefaultTTL)
+	return info
+}
+
+func (e *PwExtractor) headEnclosure(enclosureUrl string, deadline time.Time) (enclosureInfo, error) {
+	ctx, cancel := context.WithDeadline(context.Background(), budgetDeadline(enclosureHeadTimeout, deadline))
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, enclosureUrl, nil)
+	if err != nil {
+		return enclosureInfo{}, fmt.Errorf("create request: %w", err)
+	}
+	resp, err := e.doRequest(req)
+	if err != nil {
+		return enclosureInfo{}, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return enclosureInfo{}, fmt.Errorf("bad status: %s", resp.Status)
+	}
+	var info enclosureInfo
+	if mimeType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+		info.mimeType = mimeType
+	}
+	if resp.ContentLength > 0 {
+		info.length = resp.ContentLength
+	}
+	return info, nil
+}
+
+// mimeTypeByExtension returns MIME type without parameters or empty string if unknown
+func mimeTypeByExtension(rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return ""
+	}
+	mimeType, _, err := mime.ParseMediaType(mime.TypeByExtension(path.Ext(u.Path)))
+	if err != nil {
+		return ""
+	}
+	return mimeType
+}
@@ -0,0 +1,77 @@
+// let fnc = // for autocomplete
+({post, fields}) => {
+    // same as playwright: xpath starting with / is relative to scope element
+    const xpathResult = (root, expr, type) => {
+        if (expr.startsWith('/') && root !== document) {
+            expr = '.' + expr;
+        }
+        return document.evaluate(expr, root, null, type, null);
+    };
+
+    const findAll = (root, selector) => {
+        if (!selector.xpath) {
+            return Array.from(root.querySelectorAll(selector.value));
+        }
+        const snapshot = xpathResult(root, selector.value, XPathResult.ORDERED_NODE_SNAPSHOT_TYPE);
+        const elements = [];
+        for (let i = 0; i < snapshot.snapshotLength; i++) {
+            const node = snapshot.snapshotItem(i);
+            if (node.nodeType === Node.ELEMENT_NODE) {
+                elements.push(node);
+            }
+        }
+        return elements;
+    };
+
+    const findFirst = (root, selector) => {
+        if (!selector.xpath) {
+            return root.querySelector(selector.value);
+        }
+        const node = xpathResult(root, selector.value, XPathResult.FIRST_ORDERED_NODE_TYPE).singleNodeValue;
+        return node && node.nodeType === Node.ELEMENT_NODE ? node : null;
+    };
+
+    // like playwright isVisible: non-empty box and not visibility:hidden
+    const isVisible = el => {
+        const style = window.getComputedStyle(el);
+        if (style.visibility !== 'visible') {
+            return false;
+        }
+        const rect = el.getBoundingClientRect();
+        return rect.width > 0 && rect.height > 0;
+    };
+
+    // extract from values match models.ExtractFrom
+    const read = (el, field) => {
+        if (field.content) {
+            try {
+                return extractContent(el);
+            } catch (e) {
+                return isVisible(el) ? el.textContent : '';
+            }
+        }
+        if (!isVisible(el)) {
+            return '';
+        }
+        switch (field.extract_from) {
+            case 0:
+                return el.innerText;
+            case 1:
+                return el.getAttribute(field.attribute) ?? '';
+            case 2:
+                return el.innerHTML;
+            case 3:
+                return el.textContent ?? '';
+        }
+        throw new Error(`invalid extract from ${field.extract_from}`);
+    };
+
+    return findAll(document, post).map(postEl => {
+        const values = {};
+        for (const field of fields) {
+            const el = findFirst(postEl, field.selector);
+            values[field.name] = el ? read(el, field) : '';
+        }
+        return values;
+    });
+}
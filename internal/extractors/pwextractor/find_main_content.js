@@ -0,0 +1,61 @@
+// Readability-like heuristic: paragraphs give score to their parent and grandparent,
+// best scored element is marked with attribute
+// let fnc = // for autocomplete
+markAttr => {
+    const scores = new Map();
+    const addScore = (el, score) => {
+        if (!el || el === document.body || el === document.documentElement) {
+            return;
+        }
+        scores.set(el, (scores.get(el) || 0) + score);
+    };
+
+    const unlikely = /comment|footer|header|menu|nav|sidebar|share|related|promo|banner|cookie|popup|ad-|ads/i;
+    const isUnlikely = el => {
+        for (let node = el; node && node !== document.body; node = node.parentElement) {
+            const tag = node.tagName.toLowerCase();
+            if (['nav', 'aside', 'footer', 'header', 'form'].includes(tag)) {
+                return true;
+            }
+            if (unlikely.test(`${node.className} ${node.id}`) && !['article', 'main'].includes(tag)) {
+                return true;
+            }
+        }
+        return false;
+    };
+
+    for (let p of document.querySelectorAll('p, pre, td, blockquote')) {
+        const text = p.innerText.trim();
+        if (text.length < 25 || isUnlikely(p)) {
+            continue;
+        }
+        const score = 1 + text.split(',').length + Math.min(Math.floor(text.length / 100), 3);
+        addScore(p.parentElement, score);
+        addScore(p.parentElement && p.parentElement.parentElement, score / 2);
+    }
+
+    for (let el of document.querySelectorAll('article, main, [itemprop=articleBody], [role=main]')) {
+        addScore(el, 25);
+    }
+
+    let best = null;
+    let bestScore = 0;
+    for (let [el, score] of scores) {
+        const textLength = el.innerText.length || 1;
+        let linkLength = 0;
+        for (let a of el.querySelectorAll('a')) {
+            linkLength += a.innerText.length;
+        }
+        const finalScore = score * (1 - linkLength / textLength);
+        if (finalScore > bestScore) {
+            best = el;
+            bestScore = finalScore;
+        }
+    }
+
+    if (!best) {
+        return false;
+    }
+    best.setAttribute(markAttr, '');
+    return true;
+}
@@ -0,0 +1,125 @@
+package pwextractor
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/gommon/log"
+	"github.com/playwright-community/playwright-go"
+	"maps"
+	"time"
+)
+
+// Articles are fetched until limits are reached, remaining ones are fetched on next feed refreshes
+var (
+	fullTextMaxArticles = 10
+	fullTextBudget      = 30 * time.Second
+)
+
+//go:embed find_main_content.js
+var findMainContentScript string
+
+const mainContentAttr = "data-rssalchemy-main"
+
+// enrichFullText visits item links and replaces item content with article body
+func (e *PwExtractor) enrichFullText(task models.Task, items []models.FeedItem, taskDeadline time.Time) {
+	deadline := budgetDeadline(fullTextBudget, taskDeadline)
+	fetched := 0
+	for i := range items {
+		item := &items[i]
+		key := articleCacheKey(task, item.Link)
+		content, found, err := e.articleCache.Get(key)
+		if err != nil {
+			log.Errorf("article cache get %s: %v", item.Link, err)
+		}
+		if !found {
+			if fetched >= fullTextMaxArticles || time.Now().After(deadline) {
+				continue
+			}
+			fetched++
+			content, err = e.fetchFullText(task, item.Link, deadline)
+			if err != nil {
+				log.Warnf("full text %s: %v", item.Link, err)
+				continue
+			}
+			if err := e.articleCache.Set(key, content); err != nil {
+				log.Errorf("article cache set %s: %v", item.Link, err)
+			}
+		}
+		if len(content) > 0 {
+			item.Content = content
+		}
+	}
+	log.Infof("Full text finished, fetched %d articles of %d", fetched, len(items))
+}
+
+// fetchFullText returns empty content if article body was not found on page
+func (e *PwExtractor) fetchFullText(task models.Task, link string, deadline time.Time) (content string, errRet error) {
+	headers := make(map[string]string, len(task.Headers))
+	maps.Copy(headers, task.Headers)
+	taskDomain, _, err := parseBaseDomain(task.URL)
+	if err != nil {
+		return "", fmt.Errorf("task base domain: %w", err)
+	}
+	linkDomain, _, err := parseBaseDomain(link)
+	if err != nil {
+		return "", fmt.Errorf("link base domain: %w", err)
+	}
+	if linkDomain != taskDomain {
+		delete(headers, "Cookie") // cookies belong to task site
+	}
+	articleTask := models.Task{
+		TaskType: task.TaskType,
+		URL:      link,
+		Headers:  headers,
+	}
+
+	errRet = e.visitPage(articleTask, deadline, func(page playwright.Page) error {
+		var article playwright.Locator
+		switch task.FullTextMode {
+		case models.FullTextMode_BySelector:
+			article = page.Locator(task.SelectorFullText).First()
+		case models.FullTextMode_Auto:
+			found, err := page.Evaluate(findMainContentScript, mainContentAttr)
+			if err != nil {
+				return fmt.Errorf("find main content: %w", err)
+			}
+			if found != true {
+				log.Debugf("Main content not found: %s", link)
+				return nil
+			}
+			article = page.Locator(fmt.Sprintf("[%s]", mainContentAttr)).First()
+		default:
+			return fmt.Errorf("invalid task.FullTextMode")
+		}
+		if err := article.WaitFor(playwright.LocatorWaitForOptions{Timeout: pwDuration("5s")}); err != nil {
+			return fmt.Errorf("wait for article: %w", err)
+		}
+		result, err := article.Evaluate(
+			extractPostScript,
+			nil,
+			playwright.LocatorEvaluateOptions{Timeout: pwDuration("1s")},
+		)
+		if err != nil {
+			return fmt.Errorf("extract article: %w", err)
+		}
+		var ok bool
+		content, ok = result.(string)
+		if !ok {
+			return fmt.Errorf("extract article: result type mismatch: %v", result)
+		}
+		return nil
+	})
+	return
+}
+
+// articleCacheKey depends on extraction settings, because they affect content
+func articleCacheKey(task models.Task, link string) string {
+	h := sha256.New()
+	h.Write([]byte(fmt.Sprintf("%d", task.FullTextMode)))
+	h.Write([]byte(task.SelectorFullText))
+	h.Write([]byte{0})
+	h.Write([]byte(link))
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
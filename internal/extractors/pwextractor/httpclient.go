@@ -0,0 +1,82 @@
+package pwextractor
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// newHttpClient creates client for requests made outside of browser.
+// It uses the same proxy as browser. Without proxy, connection address is checked when dialing (see dialControl),
+// so hosts can't be resolved to banned addresses after they were checked by allowHost, redirects included.
+// With proxy, hosts are resolved by proxy, so only allowHost checks are possible
+func (e *PwExtractor) newHttpClient(proxy string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if len(proxy) > 0 {
+		proxyUrl, err := url.Parse(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("parse proxy: %w", err)
+		}
+		if proxyUrl.Scheme == "socks" {
+			proxyUrl.Scheme = "socks5" // net/http knows only this name
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	} else {
+		dialer.Control = e.dialControl
+	}
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Transport: transport,
+		Timeout:   10 * time.Second,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return fmt.Errorf("too many redirects")
+			}
+			allow, err := e.allowHost(req.URL.String())
+			if err != nil {
+				return fmt.Errorf("redirect allow host: %w", err)
+			}
+			if !allow {
+				return fmt.Errorf("redirect to banned host: %s", req.URL.Host)
+			}
+			return nil
+		},
+	}, nil
+}
+
+// dialControl refuses connections to banned addresses. Address is already resolved here, so it is the one used
+func (e *PwExtractor) dialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("dial address: %w", err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("dial address is not ip: %s", address)
+	}
+	if e.denyIP(ip) {
+		return fmt.Errorf("banned address: %s", address)
+	}
+	return nil
+}
+
+// doRequest checks host and sends request using client created by newHttpClient.
+// Host check here gives clear error early, dialControl makes the final decision
+func (e *PwExtractor) doRequest(req *http.Request) (*http.Response, error) {
+	allow, err := e.allowHost(req.URL.String())
+	if err != nil {
+		return nil, fmt.Errorf("allow host: %w", err)
+	}
+	if !allow {
+		return nil, fmt.Errorf("banned host: %s", req.URL.Host)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	return e.httpClient.Do(req)
+}
@@ -0,0 +1,30 @@
+package pwextractor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialControl(t *testing.T) {
+	e := &PwExtractor{}
+	assert.Error(t, e.dialControl("tcp", "127.0.0.1:80", nil))
+	assert.Error(t, e.dialControl("tcp", "[fe80::1]:443", nil))
+	assert.Error(t, e.dialControl("tcp", "10.0.0.1:443", nil))
+	assert.NoError(t, e.dialControl("tcp", "93.184.215.14:443", nil))
+}
+
+func TestHttpClientRefusesLocalAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	e := &PwExtractor{}
+	client, err := e.newHttpClient("")
+	require.NoError(t, err)
+	// request without allowHost check, as if host was resolved to public address before
+	_, err = client.Get(server.URL)
+	assert.ErrorContains(t, err, "banned address")
+}
@@ -0,0 +1,71 @@
+package pwextractor
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/jsonquery"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/gommon/log"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ExtractJson requests json api at task url and maps response to feed items using task.Json queries.
+// Relative links are resolved against api url
+func (e *PwExtractor) ExtractJson(task models.Task) (*models.TaskResult, error) {
+	mapping, err := jsonquery.CompileMapping(task.Json)
+	if err != nil {
+		return nil, fmt.Errorf("compile json mapping: %w", err)
+	}
+	maker, err := e.newItemMaker(task)
+	if err != nil {
+		return nil, err
+	}
+	method := http.MethodGet
+	if strings.EqualFold(task.Json.Method, http.MethodPost) {
+		method = http.MethodPost
+	}
+
+	start := time.Now()
+	deadline := start.Add(taskBudget)
+	session := e.newHttpSession(task)
+	resp, err := session.request(method, task.URL, task.Json.Body, "application/json", budgetDeadline(loadMoreBudget, deadline))
+	if err != nil {
+		return nil, fmt.Errorf("fetch json: %w", err)
+	}
+	defer resp.Body.Close()
+	var data any
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxBodySize)).Decode(&data); err != nil {
+		return nil, fmt.Errorf("decode json: %w", err)
+	}
+	session.saveCookies()
+
+	jsonItems, err := mapping.Items(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(jsonItems) == 0 {
+		return nil, fmt.Errorf("no items in json")
+	}
+	log.Debugf("Json items count=%d", len(jsonItems))
+	posts := make([]rawPost, len(jsonItems))
+	for i, item := range jsonItems {
+		posts[i] = mapping.Values(item)
+	}
+
+	var result models.TaskResult
+	result.Items, err = maker.makeItems(posts, resp.Request.URL.String())
+	if err != nil {
+		return nil, err
+	}
+	if apiUrl, err := url.Parse(task.URL); err == nil {
+		result.Title = apiUrl.Host
+	}
+	log.Infof("Json api %s finished, time=%f secs", task.URL, time.Since(start).Seconds())
+
+	e.enrich(task, &result, deadline)
+	return &result, nil
+}
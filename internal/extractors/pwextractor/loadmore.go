@@ -0,0 +1,85 @@
+package pwextractor
+
+import (
+	"github.com/labstack/gommon/log"
+	"github.com/playwright-community/playwright-go"
+	"time"
+)
+
+// Scrolling, clicking "load more" and visiting next pages stop when this time since task start
+// (or task deadline) is reached
+var loadMoreBudget = 25 * time.Second
+
+// How long to wait for new posts after each step
+var loadMoreStepTimeout = 3 * time.Second
+
+// loadMore scrolls page to the bottom and clicks "load more" button (if set) task.ScrollSteps times.
+// It stops when posts count stops growing or task.TargetItems is reached
+func (p *pageParser) loadMore() {
+	count := p.countPosts()
+	for step := 1; step <= p.task.ScrollSteps; step++ {
+		if targetReached(p.task, count) {
+			log.Debugf("Load more: target items reached, count=%d", count)
+			return
+		}
+		if time.Now().After(p.deadline) {
+			log.Infof("Load more: time budget exceeded at step %d", step)
+			return
+		}
+
+		p.scrollToBottom()
+		if len(p.task.SelectorLoadMore) > 0 {
+			loadMore := p.page.Locator(p.task.SelectorLoadMore).First()
+			if err := loadMore.Click(playwright.LocatorClickOptions{Timeout: pwDuration("1s")}); err != nil {
+				log.Debugf("Load more: click: %v", err)
+			}
+		}
+
+		newCount := p.waitPostsCount(count)
+		p.waitFullLoad()
+		log.Debugf("Load more: step %d, posts count=%d", step, newCount)
+		if newCount <= count {
+			return
+		}
+		count = newCount
+	}
+}
+
+func (p *pageParser) scrollToBottom() {
+	posts := p.page.Locator(p.task.SelectorPost)
+	// scrolling last post works for scrollable containers too
+	if err := posts.Last().ScrollIntoViewIfNeeded(playwright.LocatorScrollIntoViewIfNeededOptions{
+		Timeout: pwDuration(defTimeout),
+	}); err != nil {
+		log.Debugf("Load more: scroll last post: %v", err)
+	}
+	if _, err := p.page.Evaluate("window.scrollTo(0, document.body.scrollHeight)"); err != nil {
+		log.Debugf("Load more: scroll window: %v", err)
+	}
+}
+
+// waitPostsCount waits until posts count is greater than prevCount, returns new count
+func (p *pageParser) waitPostsCount(prevCount int) int {
+	stepDeadline := time.Now().Add(loadMoreStepTimeout)
+	if p.deadline.Before(stepDeadline) {
+		stepDeadline = p.deadline
+	}
+	count := prevCount
+	for time.Now().Before(stepDeadline) {
+		time.Sleep(200 * time.Millisecond)
+		count = p.countPosts()
+		if count > prevCount {
+			break
+		}
+	}
+	return count
+}
+
+func (p *pageParser) countPosts() int {
+	count, err := p.page.Locator(p.task.SelectorPost).Count()
+	if err != nil {
+		log.Warnf("count posts: %v", err)
+		return 0
+	}
+	return count
+}
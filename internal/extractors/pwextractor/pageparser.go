@@ -5,8 +5,11 @@ import (
 	_ "embed"
 	"fmt"
 	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/egor3f/rssalchemy/internal/transform"
 	"github.com/labstack/gommon/log"
 	"github.com/playwright-community/playwright-go"
+	"strings"
+	"time"
 )
 
 // Timeouts
@@ -14,10 +17,18 @@ var (
 	defTimeout = "100ms"
 )
 
-type pageParser struct {
+// itemMaker turns raw field values into feed items, it does not depend on how page was loaded
+type itemMaker struct {
 	task       models.Task
-	page       playwright.Page
 	dateParser DateParser
+	firstSeen  FirstSeenStore
+	transforms *transform.Pipeline
+}
+
+type pageParser struct {
+	itemMaker
+	page     playwright.Page
+	deadline time.Time // for loading more posts and pages
 
 	// next fields only for debugging. Shit code, to do better later
 	postIdx  int
@@ -35,40 +46,98 @@ func (p *pageParser) parse() (*models.TaskResult, error) {
 		return nil, fmt.Errorf("page title: %w", err)
 	}
 
-	iconUrl, err := p.page.Locator("link[rel=apple-touch-icon]").First().
-		GetAttribute("href", playwright.LocatorGetAttributeOptions{Timeout: pwDuration("100ms")})
+	p.extractMeta(&result)
+
+	if p.task.ScrollSteps > 0 && p.useSelectors() {
+		p.loadMore() // posts are counted by selector
+	}
+
+	result.Items, err = p.parseItems()
 	if err != nil {
-		log.Warnf("page icon url: %v", err)
-	} else {
-		result.Icon = absUrl(iconUrl, p.page)
+		return nil, err
 	}
 
-	posts, err := p.page.Locator(p.task.SelectorPost).All()
+	return &result, nil
+}
+
+// parseItems extracts posts from current page, page must be already loaded.
+// Structured data is used instead of selectors or when they fail, depending on task
+func (p *pageParser) parseItems() ([]models.FeedItem, error) {
+	if !p.useSelectors() {
+		return p.parseStructured()
+	}
+	items, err := p.parseBySelectors()
+	if err != nil && p.task.StructuredData == models.StructuredDataMode_Fallback {
+		log.Infof("Selectors failed, using structured data: %v", err)
+		return p.parseStructured()
+	}
+	return items, err
+}
+
+// parseBySelectors extracts posts using task selectors.
+// Fast batch extraction is tried first, locators are used if selectors can't run in browser directly
+func (p *pageParser) parseBySelectors() ([]models.FeedItem, error) {
+	fields, err := p.postFields()
 	if err != nil {
-		return nil, fmt.Errorf("post locator: %w", err)
+		return nil, err
+	}
+
+	posts, err := p.extractBatch(fields)
+	if err != nil {
+		log.Debugf("Batch extraction is not used: %v", err)
+		posts, err = p.extractByLocators(fields)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if len(posts) == 0 {
 		return nil, fmt.Errorf("no posts on page")
 	}
 	log.Debugf("Posts count=%d", len(posts))
 
-	for _, post := range posts {
-		item, err := p.extractPost(post)
-		if err != nil {
-			log.Errorf("extract post fields: %v", err)
+	return p.makeItems(posts, p.page.URL())
+}
+
+// extractMeta fills feed-level fields from page head, first found selector wins
+func (p *pageParser) extractMeta(result *models.TaskResult) {
+	result.Language = p.pageAttribute("lang", "html")
+	result.Description = p.pageAttribute(
+		"content",
+		"meta[name=description]",
+		"meta[property='og:description']",
+	)
+	result.Icon = p.pageAttribute(
+		"href",
+		"link[rel=apple-touch-icon]",
+		"link[rel=icon]",
+		"link[rel='shortcut icon']",
+	)
+	if len(result.Icon) > 0 {
+		result.Icon = absUrl(result.Icon, p.page)
+	}
+	result.Image = p.pageAttribute("content", "meta[property='og:image']")
+	if len(result.Image) > 0 {
+		result.Image = absUrl(result.Image, p.page)
+	}
+}
+
+// pageAttribute returns trimmed attribute of first element matching any of selectors, empty if none found
+func (p *pageParser) pageAttribute(attribute string, selectors ...string) string {
+	for _, selector := range selectors {
+		loc := p.page.Locator(selector).First()
+		if count, err := loc.Count(); err != nil || count == 0 {
 			continue
 		}
-		if len(item.Title) == 0 || len(item.Link) == 0 || item.Created.IsZero() {
-			log.Warnf("post has no required fields, skip")
+		value, err := loc.GetAttribute(attribute, playwright.LocatorGetAttributeOptions{Timeout: pwDuration(defTimeout)})
+		if err != nil {
+			log.Warnf("page attribute %s of %s: %v", attribute, selector, err)
 			continue
 		}
-		result.Items = append(result.Items, item)
-	}
-	if len(result.Items) == 0 {
-		return nil, fmt.Errorf("extract failed for all posts")
+		if value = strings.TrimSpace(value); len(value) > 0 {
+			return value
+		}
 	}
-
-	return &result, nil
+	return ""
 }
 
 func (p *pageParser) waitFullLoad() {
@@ -87,59 +156,208 @@ func (p *pageParser) waitFullLoad() {
 	<-ctx.Done()
 }
 
-func (p *pageParser) extractPost(post playwright.Locator) (models.FeedItem, error) {
-	p.fieldIdx = 0
-	p.postIdx++
+// Post field names, keys of rawPost
+const (
+	fieldTitle       = "title"
+	fieldLink        = "link"
+	fieldDescription = "description"
+	fieldAuthor      = "author"
+	fieldAuthorLink  = "author_link"
+	fieldContent     = "content"
+	fieldEnclosure   = "enclosure"
+	fieldId          = "id"
+	fieldCreated     = "created"
+	fieldUpdated     = "updated"
+)
+
+// rawPost is field values as read from page, before transforms and parsing.
+// Fields without selector are absent, fields whose element was not found are empty
+type rawPost map[string]string
+
+// postField describes how to read one field of post. Both locator and batch extraction use it
+type postField struct {
+	Name        string
+	Selector    string
+	ExtractFrom models.ExtractFrom
+	Attribute   string
+	Content     bool // use extract_post.js, ExtractFrom is ignored
+}
+
+// postFields lists fields with selectors set in task
+func (p *itemMaker) postFields() ([]postField, error) {
+	t := p.task
+	linkFrom, linkAttribute := withDefaultAttribute(t.LinkExtractFrom, t.LinkAttributeName, "href")
+	enclosureFrom, enclosureAttribute := withDefaultAttribute(t.EnclosureExtractFrom, t.EnclosureAttributeName, "src")
+	idFrom := models.ExtractFrom_InnerText
+	if len(t.IdAttributeName) > 0 {
+		idFrom = models.ExtractFrom_Attribute
+	}
+	all := []postField{
+		{Name: fieldTitle, Selector: t.SelectorTitle, ExtractFrom: t.TitleExtractFrom, Attribute: t.TitleAttributeName},
+		{Name: fieldLink, Selector: t.SelectorLink, ExtractFrom: linkFrom, Attribute: linkAttribute},
+		{
+			Name:        fieldDescription,
+			Selector:    t.SelectorDescription,
+			ExtractFrom: t.DescriptionExtractFrom,
+			Attribute:   t.DescriptionAttributeName,
+		},
+		{Name: fieldAuthor, Selector: t.SelectorAuthor, ExtractFrom: t.AuthorExtractFrom, Attribute: t.AuthorAttributeName},
+		{Name: fieldAuthorLink, Selector: t.SelectorAuthor, ExtractFrom: models.ExtractFrom_Attribute, Attribute: "href"},
+		{Name: fieldContent, Selector: t.SelectorContent, Content: true},
+		{Name: fieldEnclosure, Selector: t.SelectorEnclosure, ExtractFrom: enclosureFrom, Attribute: enclosureAttribute},
+		{Name: fieldId, Selector: t.SelectorId, ExtractFrom: idFrom, Attribute: t.IdAttributeName},
+		{Name: fieldCreated, Selector: t.SelectorCreated, ExtractFrom: t.CreatedExtractFrom, Attribute: t.CreatedAttributeName},
+		{Name: fieldUpdated, Selector: t.SelectorUpdated, ExtractFrom: t.UpdatedExtractFrom, Attribute: t.UpdatedAttributeName},
+	}
+	var fields []postField
+	for _, field := range all {
+		if len(field.Selector) == 0 {
+			continue
+		}
+		switch field.ExtractFrom {
+		case models.ExtractFrom_InnerText, models.ExtractFrom_Attribute,
+			models.ExtractFrom_InnerHtml, models.ExtractFrom_TextContent:
+		default:
+			return nil, fmt.Errorf("%s: invalid extract from %d", field.Name, field.ExtractFrom)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// extractByLocators reads fields one by one, it is slow, but supports all playwright selectors
+func (p *pageParser) extractByLocators(fields []postField) ([]rawPost, error) {
+	posts, err := p.page.Locator(p.task.SelectorPost).All()
+	if err != nil {
+		return nil, fmt.Errorf("post locator: %w", err)
+	}
+	result := make([]rawPost, 0, len(posts))
+	for _, post := range posts {
+		p.fieldIdx = 0
+		p.postIdx++
+		raw := make(rawPost, len(fields))
+		for _, field := range fields {
+			loc := newLocator(post, field.Selector).First()
+			if field.Content {
+				raw[field.Name] = p.extractContent(loc)
+				continue
+			}
+			raw[field.Name], err = loc.Value(field.ExtractFrom, field.Attribute)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", field.Name, err)
+			}
+		}
+		result = append(result, raw)
+	}
+	return result, nil
+}
+
+// makeItems makes items from raw posts, skipping ones without title or link.
+// Posts without date get first seen time
+func (p *itemMaker) makeItems(posts []rawPost, baseUrl string) ([]models.FeedItem, error) {
+	var items []models.FeedItem
+	var err error
+	for _, post := range posts {
+		item := p.makeItem(post, baseUrl)
+		if len(item.Title) == 0 || len(item.Link) == 0 {
+			log.Warnf("post has no required fields, skip")
+			continue
+		}
+		if item.Created.IsZero() {
+			item.Created, err = p.firstSeen.FirstSeen(item.Link)
+			if err != nil {
+				// current time would change date (and id) of the item on every extraction
+				return nil, fmt.Errorf("first seen store: %w", err)
+			}
+			log.Debugf("No date, using first seen time=%v", item.Created)
+		}
+		if item.Updated.Before(item.Created) {
+			item.Updated = item.Created
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("extract failed for all posts")
+	}
+
+	return items, nil
+}
+
+// makeItem applies transforms to raw values, makes links absolute and parses dates
+func (p *itemMaker) makeItem(raw rawPost, baseUrl string) models.FeedItem {
 	var item models.FeedItem
 
-	item.Title = newLocator(post, p.task.SelectorTitle).First().InnerText()
+	item.Title = p.transforms.Apply("title", raw[fieldTitle])
 	log.Debugf("---- POST: %s ----", item.Title)
 
-	item.Link = newLocator(post, p.task.SelectorLink).First().GetAttribute("href")
-	page, _ := post.Page()
-	item.Link = absUrl(item.Link, page)
+	item.Link = p.transforms.Apply("link", strings.TrimSpace(raw[fieldLink]))
+	item.Link = resolveUrl(item.Link, baseUrl)
 
-	if len(p.task.SelectorDescription) > 0 {
-		item.Description = newLocator(post, p.task.SelectorDescription).First().InnerText()
+	if value, ok := raw[fieldDescription]; ok {
+		item.Description = p.transforms.Apply("description", value)
 	}
 
-	if len(p.task.SelectorAuthor) > 0 {
-		item.AuthorName = newLocator(post, p.task.SelectorAuthor).First().InnerText()
-		item.AuthorLink = newLocator(post, p.task.SelectorAuthor).First().GetAttribute("href")
-		item.AuthorLink = absUrl(item.AuthorLink, page)
+	if value, ok := raw[fieldAuthor]; ok {
+		item.AuthorName = p.transforms.Apply("author", value)
+		item.AuthorLink = resolveUrl(raw[fieldAuthorLink], baseUrl)
 	}
 
-	if len(p.task.SelectorContent) > 0 {
-		item.Content = p.extractContent(post)
+	if value, ok := raw[fieldContent]; ok {
+		item.Content = p.transforms.Apply("content", value)
 	}
 
-	item.Enclosure = newLocator(post, p.task.SelectorEnclosure).First().GetAttribute("src")
+	item.Enclosure = p.transforms.Apply("enclosure", strings.TrimSpace(raw[fieldEnclosure]))
+	item.Enclosure = resolveUrl(item.Enclosure, baseUrl)
 
-	var createdDateStr string
-	switch p.task.CreatedExtractFrom {
-	case models.ExtractFrom_InnerText:
-		createdDateStr = newLocator(post, p.task.SelectorCreated).First().InnerText()
-	case models.ExtractFrom_Attribute:
-		createdDateStr = newLocator(post, p.task.SelectorCreated).First().GetAttribute(p.task.CreatedAttributeName)
-	default:
-		return models.FeedItem{}, fmt.Errorf("invalid task.CreatedExtractFrom")
+	item.Id = raw[fieldId]
+
+	if value, ok := raw[fieldCreated]; ok {
+		item.Created = p.parseDate("created", value)
+	}
+	if value, ok := raw[fieldUpdated]; ok {
+		item.Updated = p.parseDate("updated", value)
 	}
-	log.Debugf("date=%s", createdDateStr)
-	createdDate, err := p.dateParser.ParseDate(createdDateStr)
+
+	p.transforms.ApplyTemplates(&item)
+	// template output may be relative link too
+	item.Link = resolveUrl(item.Link, baseUrl)
+	item.Enclosure = resolveUrl(item.Enclosure, baseUrl)
+
+	return item
+}
+
+// parseDate returns zero time if date could not be parsed
+func (p *itemMaker) parseDate(field string, dateStr string) time.Time {
+	dateStr = p.transforms.Apply(field, dateStr)
+	log.Debugf("%s date=%s", field, dateStr)
+	date, err := p.dateParser.ParseDate(dateStr)
 	if err != nil {
 		log.Errorf("dateparser: %v", err)
-	} else {
-		item.Created = createdDate
+		return time.Time{}
 	}
+	return date
+}
 
-	return item, nil
+// withDefaultAttribute is for fields which are read from attribute by default (link, enclosure):
+// InnerText mode and empty attribute name mean default attribute
+func withDefaultAttribute(
+	extractFrom models.ExtractFrom,
+	attribute string,
+	defaultAttribute string,
+) (models.ExtractFrom, string) {
+	if extractFrom == models.ExtractFrom_InnerText {
+		return models.ExtractFrom_Attribute, defaultAttribute
+	}
+	if extractFrom == models.ExtractFrom_Attribute && len(attribute) == 0 {
+		return models.ExtractFrom_Attribute, defaultAttribute
+	}
+	return extractFrom, attribute
 }
 
 //go:embed extract_post.js
 var extractPostScript string
 
-func (p *pageParser) extractContent(post playwright.Locator) string {
-	postContent := newLocator(post, p.task.SelectorContent)
+func (p *pageParser) extractContent(postContent *locator) string {
 	result, err := postContent.Evaluate(
 		extractPostScript,
 		nil,
@@ -212,6 +430,34 @@ func (l *locator) GetAttribute(name string) string {
 	return t
 }
 
+func (l *locator) InnerHTML() string {
+	if !l.checkVisible() {
+		return ""
+	}
+	t, err := l.Locator.InnerHTML(playwright.LocatorInnerHTMLOptions{Timeout: pwDuration(defTimeout)})
+	if err != nil {
+		log.Errorf("locator %s innerHTML: %v", l, err)
+		return ""
+	}
+	return t
+}
+
+// Value reads element inner text, html, text content or attribute, depending on extractFrom
+func (l *locator) Value(extractFrom models.ExtractFrom, attribute string) (string, error) {
+	switch extractFrom {
+	case models.ExtractFrom_InnerText:
+		return l.InnerText(), nil
+	case models.ExtractFrom_Attribute:
+		return l.GetAttribute(attribute), nil
+	case models.ExtractFrom_InnerHtml:
+		return l.InnerHTML(), nil
+	case models.ExtractFrom_TextContent:
+		return l.TextContent(), nil
+	default:
+		return "", fmt.Errorf("invalid extract from %d", extractFrom)
+	}
+}
+
 func (l *locator) TextContent() string {
 	if !l.checkVisible() {
 		return ""
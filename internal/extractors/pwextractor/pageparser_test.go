@@ -0,0 +1,78 @@
+package pwextractor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/egor3f/rssalchemy/internal/firstseen/dummy"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/egor3f/rssalchemy/internal/transform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_withDefaultAttribute(t *testing.T) {
+	tests := []struct {
+		name              string
+		extractFrom       models.ExtractFrom
+		attribute         string
+		expectedFrom      models.ExtractFrom
+		expectedAttribute string
+	}{
+		{"default", models.ExtractFrom_InnerText, "", models.ExtractFrom_Attribute, "href"},
+		{"attribute without name", models.ExtractFrom_Attribute, "", models.ExtractFrom_Attribute, "href"},
+		{"custom attribute", models.ExtractFrom_Attribute, "data-href", models.ExtractFrom_Attribute, "data-href"},
+		{"text content", models.ExtractFrom_TextContent, "", models.ExtractFrom_TextContent, ""},
+		{"inner html", models.ExtractFrom_InnerHtml, "", models.ExtractFrom_InnerHtml, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, attribute := withDefaultAttribute(tt.extractFrom, tt.attribute, "href")
+			assert.Equal(t, tt.expectedFrom, from)
+			assert.Equal(t, tt.expectedAttribute, attribute)
+		})
+	}
+}
+
+func TestMakeItemTemplateLink(t *testing.T) {
+	transforms, err := transform.Compile([]models.Transform{
+		{Field: "link", Type: models.TransformType_Template, Value: "/posts/{{.Title}}"},
+	})
+	require.NoError(t, err)
+	maker := itemMaker{transforms: transforms}
+	item := maker.makeItem(rawPost{fieldTitle: "hello", fieldLink: "/p/1"}, "https://example.com/blog/")
+	assert.Equal(t, "https://example.com/posts/hello", item.Link)
+}
+
+type failingFirstSeen struct{}
+
+func (failingFirstSeen) FirstSeen(string) (time.Time, error) {
+	return time.Time{}, errors.New("nats: timeout")
+}
+
+func TestMakeItemsFirstSeen(t *testing.T) {
+	transforms, err := transform.Compile(nil)
+	require.NoError(t, err)
+	posts := []rawPost{
+		{fieldTitle: "First", fieldLink: "/p/1"},
+		{fieldTitle: "No link"},
+	}
+
+	maker := itemMaker{transforms: transforms, firstSeen: dummy.New()}
+	items, err := maker.makeItems(posts, "https://example.com/")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	created := items[0].Created
+	assert.False(t, created.IsZero())
+	assert.Equal(t, created, items[0].Updated)
+
+	time.Sleep(10 * time.Millisecond)
+	items, err = maker.makeItems(posts, "https://example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, created, items[0].Created, "date of item without date is stable")
+
+	maker.firstSeen = failingFirstSeen{}
+	_, err = maker.makeItems(posts, "https://example.com/")
+	assert.ErrorContains(t, err, "first seen store")
+}
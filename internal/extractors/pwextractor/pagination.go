@@ -0,0 +1,149 @@
+package pwextractor
+
+import (
+	"context"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/gommon/log"
+	"github.com/playwright-community/playwright-go"
+	"strings"
+	"time"
+)
+
+// paginate follows next page links (or clicks next page button) and appends new items to result
+func (e *PwExtractor) paginate(parser *pageParser, result *models.TaskResult) {
+	seen := seenLinks(result.Items)
+	for pageNum := 2; pageNum <= parser.task.MaxPages; pageNum++ {
+		if targetReached(parser.task, len(result.Items)) {
+			return
+		}
+		if err := e.goNextPage(parser.page, parser.task.SelectorNextPage, parser.deadline); err != nil {
+			log.Infof("Pagination stopped at page %d: %v", pageNum, err)
+			return
+		}
+		parser.waitFullLoad()
+		if parser.task.ScrollSteps > 0 {
+			parser.loadMore()
+		}
+		items, err := parser.parseItems()
+		if err != nil {
+			log.Infof("Pagination stopped at page %d: parse: %v", pageNum, err)
+			return
+		}
+		added := appendNewItems(result, seen, items)
+		log.Debugf("Page %d: items=%d, new=%d", pageNum, len(items), added)
+		if added == 0 {
+			return
+		}
+	}
+}
+
+// targetReached is true when task has enough items, so no more pages or posts are loaded
+func targetReached(task models.Task, count int) bool {
+	return task.TargetItems > 0 && count >= task.TargetItems
+}
+
+func seenLinks(items []models.FeedItem) map[string]struct{} {
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		seen[item.Link] = struct{}{}
+	}
+	return seen
+}
+
+// appendNewItems appends items with links not seen before and returns their count
+func appendNewItems(result *models.TaskResult, seen map[string]struct{}, items []models.FeedItem) int {
+	added := 0
+	for _, item := range items {
+		if _, ok := seen[item.Link]; ok {
+			continue
+		}
+		seen[item.Link] = struct{}{}
+		result.Items = append(result.Items, item)
+		added++
+	}
+	return added
+}
+
+// isPageLink is false for anchors and javascript links, which need a click instead
+func isPageLink(href string) bool {
+	return len(href) > 0 && !strings.HasPrefix(href, "#") && !strings.HasPrefix(strings.ToLower(href), "javascript:")
+}
+
+// goNextPage navigates to href of next page element if it is a link, otherwise clicks it
+func (e *PwExtractor) goNextPage(page playwright.Page, selector string, deadline time.Time) error {
+	next := page.Locator(selector).First()
+	count, err := next.Count()
+	if err != nil {
+		return fmt.Errorf("next page locator: %w", err)
+	}
+	if count == 0 {
+		return fmt.Errorf("no next page element")
+	}
+
+	href, err := next.GetAttribute("href", playwright.LocatorGetAttributeOptions{Timeout: pwDuration(defTimeout)})
+	if err != nil {
+		log.Debugf("next page href: %v", err)
+	}
+	href = strings.TrimSpace(href)
+	isLink := isPageLink(href)
+
+	nextUrl := page.URL()
+	if isLink {
+		nextUrl = absUrl(href, page)
+		if nextUrl == page.URL() {
+			return fmt.Errorf("next page is current page")
+		}
+		allow, err := e.allowHost(nextUrl)
+		if err != nil {
+			return fmt.Errorf("allow host: %w", err)
+		}
+		if !allow {
+			return fmt.Errorf("banned host: %s", nextUrl)
+		}
+	}
+
+	if err := e.waitLimiter(nextUrl, deadline); err != nil {
+		return err
+	}
+	timeout := min(time.Until(deadline), 10*time.Second)
+	if timeout <= 0 {
+		return fmt.Errorf("time budget exceeded")
+	}
+
+	if isLink {
+		log.Debugf("Going to next page %s", nextUrl)
+		_, err := page.Goto(nextUrl, playwright.PageGotoOptions{Timeout: pwDuration(timeout.String())})
+		if err != nil {
+			return fmt.Errorf("goto next page: %w", err)
+		}
+	} else {
+		log.Debugf("Clicking next page button")
+		if err := next.Click(playwright.LocatorClickOptions{Timeout: pwDuration("2s")}); err != nil {
+			return fmt.Errorf("click next page: %w", err)
+		}
+	}
+	return nil
+}
+
+// waitLimiter waits for per-domain limiter, but not past deadline.
+// Slot is not reserved if wait exceeds deadline, so other tasks for the domain are not delayed by request never made
+func (e *PwExtractor) waitLimiter(rawUrl string, deadline time.Time) error {
+	baseDomain, _, err := parseBaseDomain(rawUrl)
+	if err != nil {
+		return fmt.Errorf("parse base domain: %w", err)
+	}
+	budget := time.Until(deadline)
+	if budget <= 0 {
+		return fmt.Errorf("time budget exceeded")
+	}
+	waitFor, err := e.limiter.Limit(context.TODO(), baseDomain, budget)
+	if err != nil {
+		return fmt.Errorf("bydomain limiter, time budget %v: %w", budget, err)
+	}
+	if waitFor > 0 {
+		log.Infof("Bydomain limiter domain=%s wait=%v", baseDomain, waitFor)
+		time.Sleep(waitFor)
+	}
+	return nil
+}
@@ -1,15 +1,16 @@
 package pwextractor
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"github.com/egor3f/rssalchemy/internal/limiter"
 	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/egor3f/rssalchemy/internal/transform"
 	"github.com/labstack/gommon/log"
 	"github.com/playwright-community/playwright-go"
 	"maps"
 	"net"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -27,22 +28,40 @@ type CookieManager interface {
 	UpdateCookies(key string, cookieHeader string, cookies [][2]string) error
 }
 
+// FirstSeenStore remembers when item link was seen for the first time.
+// It is used as item date for posts without date
+type FirstSeenStore interface {
+	FirstSeen(link string) (time.Time, error)
+}
+
+// ArticleCache stores full text of articles, so unchanged articles are not fetched on every refresh.
+// Empty content (article body not found) is kept for a short time only
+type ArticleCache interface {
+	Get(key string) (content string, found bool, err error)
+	Set(key string, content string) error
+}
+
 type PwExtractor struct {
 	pw            *playwright.Playwright
 	chrome        playwright.Browser
 	dateParser    DateParser
 	cookieManager CookieManager
+	firstSeen     FirstSeenStore
+	articleCache  ArticleCache
 	limiter       limiter.Limiter
+	httpClient    *http.Client
 	proxyIP       net.IP
 	allowed       int
 	blocked       int
 }
 
 type Config struct {
-	Proxy         string
-	DateParser    DateParser
-	CookieManager CookieManager
-	Limiter       limiter.Limiter
+	Proxy          string
+	DateParser     DateParser
+	CookieManager  CookieManager
+	FirstSeenStore FirstSeenStore
+	ArticleCache   ArticleCache
+	Limiter        limiter.Limiter
 }
 
 func New(cfg Config) (*PwExtractor, error) {
@@ -63,6 +82,10 @@ func New(cfg Config) (*PwExtractor, error) {
 		}
 		e.proxyIP = proxyIPs[0]
 	}
+	e.httpClient, err = e.newHttpClient(cfg.Proxy)
+	if err != nil {
+		return nil, fmt.Errorf("create http client: %w", err)
+	}
 	e.chrome, err = e.pw.Chromium.Launch(playwright.BrowserTypeLaunchOptions{
 		Args: []string{
 			"--webrtc-ip-handling-policy=disable_non_proxied_udp",
@@ -80,8 +103,10 @@ func New(cfg Config) (*PwExtractor, error) {
 
 	e.dateParser = cfg.DateParser
 	e.cookieManager = cfg.CookieManager
+	e.firstSeen = cfg.FirstSeenStore
+	e.articleCache = cfg.ArticleCache
 	e.limiter = cfg.Limiter
-	if e.dateParser == nil || e.cookieManager == nil || e.limiter == nil {
+	if e.dateParser == nil || e.cookieManager == nil || e.firstSeen == nil || e.articleCache == nil || e.limiter == nil {
 		panic("you fckd up with di again")
 	}
 
@@ -100,7 +125,20 @@ func (e *PwExtractor) Stop() error {
 
 const MAX_RETRIES = 3 // todo: config
 
-func (e *PwExtractor) visitPage(task models.Task, cb func(page playwright.Page) error) (errRet error) {
+// Whole task has to fit into webserver task timeout (1 minute), the rest is reserve for queue and rendering.
+// Stages (loading more, full text, enclosures) have their own budgets, but never run past task deadline
+var taskBudget = 50 * time.Second
+
+// budgetDeadline is end of stage budget starting now, but not later than task deadline
+func budgetDeadline(budget time.Duration, taskDeadline time.Time) time.Time {
+	if stageDeadline := time.Now().Add(budget); stageDeadline.Before(taskDeadline) {
+		return stageDeadline
+	}
+	return taskDeadline
+}
+
+// visitPage opens task url in new browser context and runs cb on it. Limiter wait and page loading end by deadline
+func (e *PwExtractor) visitPage(task models.Task, deadline time.Time, cb func(page playwright.Page) error) (errRet error) {
 
 	taskUrl, err := url.Parse(task.URL)
 	if err != nil {
@@ -112,13 +150,8 @@ func (e *PwExtractor) visitPage(task models.Task, cb func(page playwright.Page)
 		return fmt.Errorf("parse base domain: %w", err)
 	}
 
-	waitFor, err := e.limiter.Limit(context.TODO(), baseDomain)
-	if err != nil {
-		return fmt.Errorf("bydomain limiter: %w", err)
-	}
-	if waitFor > 0 {
-		log.Infof("Bydomain limiter domain=%s wait=%v", baseDomain, waitFor)
-		time.Sleep(waitFor) // todo: task timeouts
+	if err := e.waitLimiter(task.URL, deadline); err != nil {
+		return err
 	}
 
 	headers := maps.Clone(task.Headers)
@@ -192,7 +225,12 @@ func (e *PwExtractor) visitPage(task models.Task, cb func(page playwright.Page)
 	}
 
 	for retry := 0; retry < MAX_RETRIES; retry++ {
-		_, err = page.Goto(task.URL, playwright.PageGotoOptions{Timeout: pwDuration("10s")})
+		timeout := min(time.Until(deadline), 10*time.Second)
+		if timeout <= 0 {
+			err = fmt.Errorf("time budget exceeded")
+			break
+		}
+		_, err = page.Goto(task.URL, playwright.PageGotoOptions{Timeout: pwDuration(timeout.String())})
 		if !errors.Is(err, playwright.ErrTimeout) {
 			break
 		}
@@ -201,7 +239,12 @@ func (e *PwExtractor) visitPage(task models.Task, cb func(page playwright.Page)
 	if err != nil {
 		return fmt.Errorf("goto page: %w", err)
 	}
-	log.Debugf("Url %s visited, starting cb", task.URL)
+	log.Debugf("Url %s visited", task.URL)
+
+	if err := runActions(page, task.Actions, deadline); err != nil {
+		return fmt.Errorf("actions: %w", err)
+	}
+	log.Debugf("Starting cb")
 
 	start := time.Now()
 	err = cb(page)
@@ -295,9 +338,7 @@ func (e *PwExtractor) allowHost(rawUrl string) (bool, error) {
 		return false, fmt.Errorf("allow host get ips: %w", err)
 	}
 	for _, ip := range ips {
-		deny := ip.IsPrivate() || ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsMulticast()
-		deny = deny || e.proxyIP.Equal(ip)
-		if deny {
+		if e.denyIP(ip) {
 			log.Warnf("Banned address: %s", rawUrl)
 			return false, nil
 		}
@@ -305,45 +346,108 @@ func (e *PwExtractor) allowHost(rawUrl string) (bool, error) {
 	return true, nil
 }
 
-func (e *PwExtractor) Extract(task models.Task) (result *models.TaskResult, errRet error) {
-	errRet = e.visitPage(task, func(page playwright.Page) error {
+// denyIP is true for addresses of local network and proxy itself
+func (e *PwExtractor) denyIP(ip net.IP) bool {
+	deny := ip.IsPrivate() || ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsMulticast()
+	return deny || e.proxyIP.Equal(ip)
+}
+
+func (e *PwExtractor) newItemMaker(task models.Task) (itemMaker, error) {
+	transforms, err := transform.Compile(task.Transforms)
+	if err != nil {
+		return itemMaker{}, fmt.Errorf("compile transforms: %w", err)
+	}
+	return itemMaker{
+		task:       task,
+		dateParser: e.dateParser,
+		firstSeen:  e.firstSeen,
+		transforms: transforms,
+	}, nil
+}
+
+func (e *PwExtractor) Extract(task models.Task) (*models.TaskResult, error) {
+	return e.extract(task, time.Now().Add(taskBudget))
+}
+
+func (e *PwExtractor) extract(task models.Task, deadline time.Time) (result *models.TaskResult, errRet error) {
+	maker, err := e.newItemMaker(task)
+	if err != nil {
+		return nil, err
+	}
+	loadMoreDeadline := budgetDeadline(loadMoreBudget, deadline)
+	errRet = e.visitPage(task, deadline, func(page playwright.Page) error {
 		parser := pageParser{
-			task:       task,
-			page:       page,
-			dateParser: e.dateParser,
+			itemMaker: maker,
+			page:      page,
+			deadline:  loadMoreDeadline,
 		}
 		var err error
 		result, err = parser.parse()
 		if err != nil {
 			return fmt.Errorf("parse page: %w", err)
 		}
+		if len(task.SelectorNextPage) > 0 && task.MaxPages > 1 {
+			e.paginate(&parser, result)
+		}
 		return nil
 	})
+	if errRet == nil {
+		e.enrich(task, result, deadline)
+	}
 	return
 }
 
+// enrich runs steps which are made after posts are extracted, independent of render mode
+func (e *PwExtractor) enrich(task models.Task, result *models.TaskResult, deadline time.Time) {
+	if task.FullTextMode != models.FullTextMode_Off {
+		e.enrichFullText(task, result.Items, deadline)
+	}
+	e.resolveEnclosures(result.Items, deadline)
+}
+
 func (e *PwExtractor) Screenshot(task models.Task) (result *models.ScreenshotTaskResult, errRet error) {
-	errRet = e.visitPage(task, func(page playwright.Page) error {
-		err := page.WaitForLoadState(playwright.PageWaitForLoadStateOptions{
-			State:   playwright.LoadStateNetworkidle,
-			Timeout: pwDuration("5s"),
-		})
+	errRet = e.visitPage(task, time.Now().Add(taskBudget), func(page playwright.Page) error {
+		screenshot, err := takeScreenshot(page)
 		if err != nil {
-			log.Debugf("Wait for network idle: %v", err)
+			return err
 		}
-		if err := page.SetViewportSize(1280, 5000); err != nil {
-			return fmt.Errorf("set viewport size: %w", err)
-		}
-		screenshot, err := page.Screenshot(playwright.PageScreenshotOptions{
-			Animations: playwright.ScreenshotAnimationsDisabled,
-			Timeout:    pwDuration("5s"),
-		})
-		if err != nil {
-			return fmt.Errorf("make screenshot: %w", err)
-		}
-		log.Infof("Screenshot finished; total size: %d bytes", len(screenshot))
 		result = &models.ScreenshotTaskResult{Image: screenshot}
 		return nil
 	})
 	return
 }
+
+// takeScreenshot waits for page to load and makes screenshot of tall viewport
+func takeScreenshot(page playwright.Page) ([]byte, error) {
+	if err := prepareScreenshot(page); err != nil {
+		return nil, err
+	}
+	return captureScreenshot(page)
+}
+
+// prepareScreenshot waits for page to load and resizes viewport, layout does not change after it
+func prepareScreenshot(page playwright.Page) error {
+	err := page.WaitForLoadState(playwright.PageWaitForLoadStateOptions{
+		State:   playwright.LoadStateNetworkidle,
+		Timeout: pwDuration("5s"),
+	})
+	if err != nil {
+		log.Debugf("Wait for network idle: %v", err)
+	}
+	if err := page.SetViewportSize(1280, 5000); err != nil {
+		return fmt.Errorf("set viewport size: %w", err)
+	}
+	return nil
+}
+
+func captureScreenshot(page playwright.Page) ([]byte, error) {
+	screenshot, err := page.Screenshot(playwright.PageScreenshotOptions{
+		Animations: playwright.ScreenshotAnimationsDisabled,
+		Timeout:    pwDuration("5s"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("make screenshot: %w", err)
+	}
+	log.Infof("Screenshot finished; total size: %d bytes", len(screenshot))
+	return screenshot, nil
+}
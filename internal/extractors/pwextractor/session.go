@@ -0,0 +1,136 @@
+package pwextractor
+
+import (
+	"context"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/cookiemgr"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/gommon/log"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const maxBodySize = 10 << 20
+
+// httpSession makes requests without browser. It keeps cookies between requests of one task, like browser context does
+type httpSession struct {
+	e          *PwExtractor
+	task       models.Task
+	baseDomain string
+	cookieStr  string
+	cookies    [][2]string
+}
+
+func (e *PwExtractor) newHttpSession(task models.Task) *httpSession {
+	s := httpSession{e: e, task: task}
+	var err error
+	s.baseDomain, _, err = parseBaseDomain(task.URL)
+	if err != nil {
+		log.Errorf("parse base domain: %v", err)
+	}
+	if v, ok := task.Headers["Cookie"]; ok {
+		s.cookieStr = v
+		s.cookies, err = e.cookieManager.GetCookies(task.URL, v)
+		if err != nil {
+			log.Errorf("cookie manager get: %v", err)
+			s.cookies = make([][2]string, 0)
+		}
+		log.Debugf("Found cookies, count=%d", len(s.cookies))
+	}
+	return &s
+}
+
+// request is sent through limiter, ssrf checks and proxy (see doRequest).
+// Response status is checked, caller must close body
+func (s *httpSession) request(method, reqUrl, body, accept string, deadline time.Time) (*http.Response, error) {
+	if err := s.e.waitLimiter(reqUrl, deadline); err != nil {
+		return nil, err
+	}
+	var bodyReader io.Reader
+	if len(body) > 0 {
+		bodyReader = strings.NewReader(body)
+	}
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	req, err := http.NewRequestWithContext(ctx, method, reqUrl, bodyReader)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	for k, v := range s.task.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Sec-Ch-Ua", secChUa)
+	if len(req.Header.Get("Accept")) == 0 {
+		req.Header.Set("Accept", accept)
+	}
+	if bodyReader != nil && len(req.Header.Get("Content-Type")) == 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Del("Cookie")
+	if domain, _, err := parseBaseDomain(reqUrl); err == nil && domain == s.baseDomain && len(s.cookies) > 0 {
+		req.Header.Set("Cookie", cookiemgr.EncodeCookieHeader(s.cookies))
+	}
+
+	resp, err := s.e.doRequest(req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("request: %w", err)
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	s.updateCookies(resp)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// cancelBody cancels request context when body is closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// updateCookies applies Set-Cookie of task site responses, only when task has cookies, like visitPage does
+func (s *httpSession) updateCookies(resp *http.Response) {
+	if len(s.cookieStr) == 0 {
+		return
+	}
+	if domain, _, err := parseBaseDomain(resp.Request.URL.String()); err != nil || domain != s.baseDomain {
+		return
+	}
+	for _, cook := range resp.Cookies() {
+		idx := -1
+		for i, old := range s.cookies {
+			if old[0] == cook.Name {
+				idx = i
+				break
+			}
+		}
+		switch {
+		case idx >= 0 && cook.MaxAge < 0:
+			s.cookies = append(s.cookies[:idx], s.cookies[idx+1:]...)
+		case idx >= 0:
+			s.cookies[idx][1] = cook.Value
+		case cook.MaxAge >= 0:
+			s.cookies = append(s.cookies, [2]string{cook.Name, cook.Value})
+		}
+	}
+}
+
+func (s *httpSession) saveCookies() {
+	if len(s.cookieStr) == 0 {
+		return
+	}
+	if err := s.e.cookieManager.UpdateCookies(s.task.URL, s.cookieStr, s.cookies); err != nil {
+		log.Errorf("cookie manager update: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package pwextractor
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/gommon/log"
+	"github.com/playwright-community/playwright-go"
+	"time"
+)
+
+//go:embed snapshot.js
+var snapshotScript string
+
+const (
+	maxSnapshotElements = 3000
+	snapshotTextLength  = 80
+)
+
+// Snapshot makes screenshot and lists visible elements on it with boxes and selectors, for visual picker.
+// Boxes are in screenshot pixels
+func (e *PwExtractor) Snapshot(task models.Task) (result *models.SnapshotTaskResult, errRet error) {
+	errRet = e.visitPage(task, time.Now().Add(taskBudget), func(page playwright.Page) error {
+		screenshot, err := takeScreenshot(page)
+		if err != nil {
+			return err
+		}
+		result, err = evaluateSnapshot(page)
+		if err != nil {
+			return err
+		}
+		result.Image = screenshot
+		log.Infof("Snapshot finished, elements=%d", len(result.Elements))
+		return nil
+	})
+	return
+}
+
+// evaluateSnapshot lists visible elements of page viewport
+func evaluateSnapshot(page playwright.Page) (*models.SnapshotTaskResult, error) {
+	snapshot, err := page.Evaluate(snapshotScript, map[string]any{
+		"maxElements": maxSnapshotElements,
+		"textLength":  snapshotTextLength,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("evaluate snapshot: %w", err)
+	}
+	snapshotJson, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot: %w", err)
+	}
+	var result models.SnapshotTaskResult
+	if err := json.Unmarshal(snapshotJson, &result); err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+	return &result, nil
+}
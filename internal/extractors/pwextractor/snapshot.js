@@ -0,0 +1,79 @@
+// let fnc = // for autocomplete
+({maxElements, textLength}) => {
+    const skipTags = new Set(['HTML', 'HEAD', 'SCRIPT', 'STYLE', 'NOSCRIPT', 'TEMPLATE', 'META', 'LINK', 'BR']);
+    // same as suggest package: generated classes like css-1x2y3 or post-123 are not stable
+    const stableIdent = /^-?[A-Za-z_][A-Za-z_-]*$/;
+
+    const stableClasses = el => Array.from(el.classList).filter(c => stableIdent.test(c));
+
+    const simpleSelector = el => (
+        el.tagName.toLowerCase() + stableClasses(el).map(c => '.' + CSS.escape(c)).join('')
+    );
+
+    const isUnique = selector => {
+        try {
+            return document.querySelectorAll(selector).length === 1;
+        } catch (e) {
+            return false;
+        }
+    };
+
+    // uniqueSelector is path of nth-of-type steps from nearest ancestor with unique id
+    const uniqueSelector = el => {
+        const steps = [];
+        for (let node = el; node; node = node.parentElement) {
+            const idSelector = '#' + CSS.escape(node.id);
+            if (node.id && isUnique(idSelector)) {
+                steps.unshift(idSelector);
+                break;
+            }
+            const tag = node.tagName.toLowerCase();
+            const parent = node.parentElement;
+            if (!parent) {
+                steps.unshift(tag);
+                break;
+            }
+            const sameTag = Array.from(parent.children).filter(c => c.tagName === node.tagName);
+            steps.unshift(sameTag.length > 1 ? `${tag}:nth-of-type(${sameTag.indexOf(node) + 1})` : tag);
+        }
+        return steps.join(' > ');
+    };
+
+    // generalSelector matches similar elements, e.g. all posts of list. Without classes parent is added
+    const generalSelector = el => {
+        if (stableClasses(el).length > 0 || !el.parentElement || el.parentElement === document.body) {
+            return simpleSelector(el);
+        }
+        return simpleSelector(el.parentElement) + ' > ' + simpleSelector(el);
+    };
+
+    const elements = [];
+    for (const el of document.body.querySelectorAll('*')) {
+        if (elements.length >= maxElements) {
+            break;
+        }
+        if (skipTags.has(el.tagName)) {
+            continue;
+        }
+        const rect = el.getBoundingClientRect();
+        if (rect.width < 1 || rect.height < 1 || rect.bottom < 0 || rect.top > window.innerHeight) {
+            continue;
+        }
+        if (window.getComputedStyle(el).visibility !== 'visible') {
+            continue;
+        }
+        elements.push({
+            tag: el.tagName.toLowerCase(),
+            id: el.id,
+            classes: Array.from(el.classList),
+            text: (el.textContent || '').replace(/\s+/g, ' ').trim().slice(0, textLength),
+            x: Math.round(rect.left),
+            y: Math.round(rect.top),
+            width: Math.round(rect.width),
+            height: Math.round(rect.height),
+            selector: uniqueSelector(el),
+            generalSelector: generalSelector(el),
+        });
+    }
+    return {width: window.innerWidth, height: window.innerHeight, elements: elements};
+}
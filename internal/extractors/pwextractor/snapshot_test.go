@@ -0,0 +1,37 @@
+package pwextractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateSnapshot(t *testing.T) {
+	p := newFixtureParser(t)
+	require.NoError(t, p.page.SetViewportSize(1280, 5000))
+	snapshot, err := evaluateSnapshot(p.page)
+	require.NoError(t, err)
+	assert.Equal(t, 1280, snapshot.Width)
+	require.NotEmpty(t, snapshot.Elements)
+
+	var article, link bool
+	for _, el := range snapshot.Elements {
+		count, err := p.page.Locator(el.Selector).Count()
+		require.NoError(t, err)
+		assert.Equal(t, 1, count, el.Selector)
+		assert.Positive(t, el.Width)
+		switch {
+		case el.Id == "post-1":
+			article = true
+			assert.Equal(t, "#post-1", el.Selector)
+			assert.Equal(t, "article.post", el.GeneralSelector)
+		case el.Text == "Post number 1" && el.Tag == "a":
+			link = true
+			assert.Equal(t, "h2.title > a", el.GeneralSelector)
+		}
+		assert.NotEqual(t, "hidden 1", el.Text, "hidden elements are skipped")
+	}
+	assert.True(t, article)
+	assert.True(t, link)
+}
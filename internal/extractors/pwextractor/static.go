@@ -0,0 +1,171 @@
+package pwextractor
+
+import (
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/gommon/log"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// staticSupported checks that task can be done without browser
+func staticSupported(task models.Task) error {
+	if len(task.Actions) > 0 {
+		return fmt.Errorf("actions need browser")
+	}
+	if task.ScrollSteps > 0 {
+		return fmt.Errorf("scrolling and load more need browser")
+	}
+	fields, err := (&itemMaker{task: task}).postFields()
+	if err != nil {
+		return err
+	}
+	var selectors []string
+	if task.StructuredData != models.StructuredDataMode_Only {
+		selectors = append(selectors, task.SelectorPost)
+		for _, field := range fields {
+			selectors = append(selectors, field.Selector)
+		}
+	}
+	if len(task.SelectorNextPage) > 0 && task.MaxPages > 1 {
+		selectors = append(selectors, task.SelectorNextPage)
+	}
+	for _, selector := range selectors {
+		if len(selector) == 0 {
+			continue // only structured data is used
+		}
+		if _, err := staticSelector(selector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExtractStatic fetches page with http client and parses it without browser.
+// Javascript is not run, so posts rendered by scripts are not found
+func (e *PwExtractor) ExtractStatic(task models.Task) (*models.TaskResult, error) {
+	return e.extractStatic(task, time.Now().Add(taskBudget))
+}
+
+func (e *PwExtractor) extractStatic(task models.Task, deadline time.Time) (*models.TaskResult, error) {
+	if err := staticSupported(task); err != nil {
+		return nil, fmt.Errorf("static mode: %w", err)
+	}
+	maker, err := e.newItemMaker(task)
+	if err != nil {
+		return nil, err
+	}
+	loadMoreDeadline := budgetDeadline(loadMoreBudget, deadline)
+	session := e.newHttpSession(task)
+
+	start := time.Now()
+	page, err := session.fetch(task.URL, loadMoreDeadline)
+	if err != nil {
+		return nil, fmt.Errorf("fetch page: %w", err)
+	}
+	var result models.TaskResult
+	extractStaticMeta(page, &result)
+	result.Items, err = maker.parseStaticItems(page)
+	if err != nil {
+		return nil, fmt.Errorf("parse page: %w", err)
+	}
+	if len(task.SelectorNextPage) > 0 && task.MaxPages > 1 {
+		session.paginate(&maker, page, &result, loadMoreDeadline)
+	}
+	session.saveCookies()
+	log.Infof("Static page %s finished, time=%f secs", task.URL, time.Since(start).Seconds())
+
+	e.enrich(task, &result, deadline)
+	return &result, nil
+}
+
+// ExtractAuto tries static mode first and falls back to browser
+// when task needs browser features or nothing was extracted from static html. Both attempts share task budget
+func (e *PwExtractor) ExtractAuto(task models.Task) (*models.TaskResult, error) {
+	deadline := time.Now().Add(taskBudget)
+	if err := staticSupported(task); err != nil {
+		log.Infof("Static mode is not supported, using browser: %v", err)
+		return e.extract(task, deadline)
+	}
+	result, err := e.extractStatic(task, deadline)
+	if err != nil {
+		log.Infof("Static mode failed, using browser: %v", err)
+		return e.extract(task, deadline)
+	}
+	return result, nil
+}
+
+// fetch gets html page
+func (s *httpSession) fetch(pageUrl string, deadline time.Time) (*staticPage, error) {
+	resp, err := s.request(http.MethodGet, pageUrl, "", "text/html,application/xhtml+xml", deadline)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	contentType := resp.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, fmt.Errorf("content type %q is not html", contentType)
+	}
+	body, err := charset.NewReader(io.LimitReader(resp.Body, maxBodySize), contentType)
+	if err != nil {
+		return nil, fmt.Errorf("charset: %w", err)
+	}
+	doc, err := html.Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("parse html: %w", err)
+	}
+	log.Debugf("Url %s fetched", pageUrl)
+	return &staticPage{doc: doc, url: resp.Request.URL.String()}, nil
+}
+
+// paginate is PwExtractor.paginate for static pages, only links are followed, buttons can't be clicked
+func (s *httpSession) paginate(maker *itemMaker, page *staticPage, result *models.TaskResult, deadline time.Time) {
+	nextSelector, err := staticSelector(s.task.SelectorNextPage)
+	if err != nil {
+		log.Errorf("Pagination: %v", err)
+		return
+	}
+	seen := seenLinks(result.Items)
+	for pageNum := 2; pageNum <= s.task.MaxPages; pageNum++ {
+		if targetReached(s.task, len(result.Items)) {
+			return
+		}
+		next := selectFirst(nextSelector, page.doc)
+		if next == nil {
+			log.Infof("Pagination stopped at page %d: no next page element", pageNum)
+			return
+		}
+		href := strings.TrimSpace(getAttr(next, "href"))
+		if !isPageLink(href) {
+			log.Infof("Pagination stopped at page %d: next page element is not a link", pageNum)
+			return
+		}
+		nextUrl := resolveUrl(href, page.url)
+		if nextUrl == page.url {
+			log.Infof("Pagination stopped at page %d: next page is current page", pageNum)
+			return
+		}
+		page, err = s.fetch(nextUrl, deadline)
+		if err != nil {
+			log.Infof("Pagination stopped at page %d: %v", pageNum, err)
+			return
+		}
+		items, err := maker.parseStaticItems(page)
+		if err != nil {
+			log.Infof("Pagination stopped at page %d: parse: %v", pageNum, err)
+			return
+		}
+		added := appendNewItems(result, seen, items)
+		log.Debugf("Page %d: items=%d, new=%d", pageNum, len(items), added)
+		if added == 0 {
+			return
+		}
+	}
+}
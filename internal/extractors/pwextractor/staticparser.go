@@ -0,0 +1,327 @@
+package pwextractor
+
+import (
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/ericchiang/css"
+	"github.com/labstack/gommon/log"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"strings"
+	"unicode"
+)
+
+// staticPage is html document fetched without browser
+type staticPage struct {
+	doc *html.Node
+	url string // after redirects, relative links are resolved against it
+}
+
+// staticSelector compiles selector for static mode, only plain css is supported
+func staticSelector(selector string) (*css.Selector, error) {
+	if strings.HasPrefix(selector, "xpath=") || strings.Contains(selector, ">>") {
+		return nil, fmt.Errorf("selector %q needs browser", selector)
+	}
+	sel, err := css.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("selector %q needs browser: %w", selector, err)
+	}
+	return sel, nil
+}
+
+// selectAll returns matches inside root in document order, root itself is excluded like in querySelectorAll
+func selectAll(sel *css.Selector, root *html.Node) []*html.Node {
+	matched := make(map[*html.Node]bool)
+	for _, n := range sel.Select(root) {
+		if n != root {
+			matched[n] = true
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+	result := make([]*html.Node, 0, len(matched))
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if matched[c] {
+				result = append(result, c)
+			}
+			walk(c)
+		}
+	}
+	walk(root)
+	return result
+}
+
+func selectFirst(sel *css.Selector, root *html.Node) *html.Node {
+	if all := selectAll(sel, root); len(all) > 0 {
+		return all[0]
+	}
+	return nil
+}
+
+// parseStaticItems is parseItems for static page
+func (p *itemMaker) parseStaticItems(page *staticPage) ([]models.FeedItem, error) {
+	if !p.useSelectors() {
+		return p.structuredItems(page.doc, page.url)
+	}
+	items, err := p.parseStaticBySelectors(page)
+	if err != nil && p.task.StructuredData == models.StructuredDataMode_Fallback {
+		log.Infof("Selectors failed, using structured data: %v", err)
+		return p.structuredItems(page.doc, page.url)
+	}
+	return items, err
+}
+
+func (p *itemMaker) parseStaticBySelectors(page *staticPage) ([]models.FeedItem, error) {
+	fields, err := p.postFields()
+	if err != nil {
+		return nil, err
+	}
+	postSel, err := staticSelector(p.task.SelectorPost)
+	if err != nil {
+		return nil, fmt.Errorf("post: %w", err)
+	}
+	fieldSels := make([]*css.Selector, len(fields))
+	for i, field := range fields {
+		fieldSels[i], err = staticSelector(field.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field.Name, err)
+		}
+	}
+
+	var posts []rawPost
+	for _, postNode := range selectAll(postSel, page.doc) {
+		raw := make(rawPost, len(fields))
+		for i, field := range fields {
+			raw[field.Name] = ""
+			if el := selectFirst(fieldSels[i], postNode); el != nil {
+				raw[field.Name] = staticValue(el, field, page.url)
+			}
+		}
+		posts = append(posts, raw)
+	}
+	if len(posts) == 0 {
+		return nil, fmt.Errorf("no posts on page")
+	}
+	log.Debugf("Posts count=%d", len(posts))
+
+	return p.makeItems(posts, page.url)
+}
+
+// staticValue reads field like locator.Value does, invisible elements give empty value
+func staticValue(el *html.Node, field postField, baseUrl string) string {
+	if field.Content {
+		return staticContent(el, baseUrl)
+	}
+	if !staticVisible(el) {
+		return ""
+	}
+	switch field.ExtractFrom {
+	case models.ExtractFrom_Attribute:
+		return getAttr(el, field.Attribute)
+	case models.ExtractFrom_InnerHtml:
+		return innerHTML(el)
+	case models.ExtractFrom_TextContent:
+		return textContent(el)
+	default:
+		return innerText(el)
+	}
+}
+
+// extractStaticMeta is extractMeta for static page
+func extractStaticMeta(page *staticPage, result *models.TaskResult) {
+	if title := staticFirst(page.doc, "title"); title != nil {
+		result.Title = strings.Join(strings.Fields(textContent(title)), " ")
+	}
+	result.Language = staticAttribute(page.doc, "lang", "html")
+	result.Description = staticAttribute(
+		page.doc,
+		"content",
+		"meta[name=description]",
+		"meta[property='og:description']",
+	)
+	result.Icon = resolveUrl(staticAttribute(
+		page.doc,
+		"href",
+		"link[rel=apple-touch-icon]",
+		"link[rel=icon]",
+		"link[rel='shortcut icon']",
+	), page.url)
+	result.Image = resolveUrl(staticAttribute(page.doc, "content", "meta[property='og:image']"), page.url)
+}
+
+// staticFirst is selectFirst for selectors known to be valid
+func staticFirst(root *html.Node, selector string) *html.Node {
+	return selectFirst(css.MustParse(selector), root)
+}
+
+// staticAttribute is pageAttribute for static page
+func staticAttribute(doc *html.Node, attribute string, selectors ...string) string {
+	for _, selector := range selectors {
+		el := staticFirst(doc, selector)
+		if el == nil {
+			continue
+		}
+		if value := strings.TrimSpace(getAttr(el, attribute)); len(value) > 0 {
+			return value
+		}
+	}
+	return ""
+}
+
+func getAttr(n *html.Node, name string) string {
+	for _, attr := range n.Attr {
+		if attr.Namespace == "" && strings.EqualFold(attr.Key, name) {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// staticVisible approximates playwright isVisible without layout:
+// element and its ancestors must not be hidden by attribute or inline style
+func staticVisible(n *html.Node) bool {
+	for ; n != nil; n = n.Parent {
+		if n.Type != html.ElementNode {
+			continue
+		}
+		switch n.DataAtom {
+		case atom.Head, atom.Script, atom.Style, atom.Template, atom.Noscript:
+			return false
+		case atom.Input:
+			if strings.EqualFold(getAttr(n, "type"), "hidden") {
+				return false
+			}
+		}
+		for _, attr := range n.Attr {
+			if attr.Key == "hidden" {
+				return false
+			}
+		}
+		style := strings.ReplaceAll(strings.ToLower(getAttr(n, "style")), " ", "")
+		if strings.Contains(style, "display:none") || strings.Contains(style, "visibility:hidden") {
+			return false
+		}
+	}
+	return true
+}
+
+func textContent(n *html.Node) string {
+	var b strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.TextNode {
+				b.WriteString(c.Data)
+			}
+			walk(c)
+		}
+	}
+	walk(n)
+	return b.String()
+}
+
+func innerHTML(n *html.Node) string {
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if err := html.Render(&b, c); err != nil {
+			log.Errorf("render inner html: %v", err)
+			return ""
+		}
+	}
+	return b.String()
+}
+
+var blockTags = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Aside: true, atom.Blockquote: true, atom.Dd: true,
+	atom.Div: true, atom.Dl: true, atom.Dt: true, atom.Figcaption: true, atom.Figure: true,
+	atom.Footer: true, atom.Form: true, atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true,
+	atom.H5: true, atom.H6: true, atom.Header: true, atom.Hr: true, atom.Li: true, atom.Main: true,
+	atom.Nav: true, atom.Ol: true, atom.P: true, atom.Pre: true, atom.Section: true, atom.Table: true,
+	atom.Tr: true, atom.Ul: true,
+}
+
+// innerText approximates browser innerText: hidden elements are skipped, whitespace is collapsed,
+// block elements and <br> start new lines
+func innerText(n *html.Node) string {
+	var b strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			switch c.Type {
+			case html.TextNode:
+				// source line breaks are whitespace, only markup breaks lines
+				b.WriteString(strings.Map(func(r rune) rune {
+					if unicode.IsSpace(r) {
+						return ' '
+					}
+					return r
+				}, c.Data))
+			case html.ElementNode:
+				if !staticVisible(c) {
+					continue
+				}
+				if c.DataAtom == atom.Br {
+					b.WriteString("\n")
+					continue
+				}
+				if blockTags[c.DataAtom] {
+					b.WriteString("\n")
+				}
+				walk(c)
+				if blockTags[c.DataAtom] {
+					b.WriteString("\n")
+				}
+			}
+		}
+	}
+	walk(n)
+
+	var lines []string
+	for _, line := range strings.Split(b.String(), "\n") {
+		if line = strings.Join(strings.Fields(line), " "); len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// staticContent is extract_post.js port, output is kept the same for both modes
+func staticContent(el *html.Node, baseUrl string) string {
+	var content, paragraph strings.Builder
+	finishParagraph := func() {
+		content.WriteString("<p>" + paragraph.String() + "</p>")
+		paragraph.Reset()
+	}
+	markup := map[atom.Atom]bool{atom.B: true, atom.I: true, atom.Strong: true}
+
+	var traverse func(n *html.Node)
+	traverse = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			switch c.Type {
+			case html.ElementNode:
+				if c.DataAtom == atom.Img {
+					finishParagraph()
+					src := resolveUrl(getAttr(c, "src"), baseUrl)
+					content.WriteString(fmt.Sprintf(`<img src="%s"/>`, html.EscapeString(src)))
+					continue
+				}
+				if markup[c.DataAtom] {
+					paragraph.WriteString("<" + c.Data + ">")
+				}
+				traverse(c)
+				if markup[c.DataAtom] {
+					paragraph.WriteString("</" + c.Data + ">")
+				}
+			case html.TextNode:
+				if len(c.Data) > 0 {
+					paragraph.WriteString(html.EscapeString(c.Data) + " ")
+				}
+			}
+		}
+	}
+	traverse(el)
+	return content.String()
+}
@@ -0,0 +1,141 @@
+package pwextractor
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/egor3f/rssalchemy/internal/dateparser"
+	dummyfirstseen "github.com/egor3f/rssalchemy/internal/firstseen/dummy"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/ericchiang/css"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+)
+
+func parseFixture(t *testing.T, source string) *html.Node {
+	doc, err := html.Parse(strings.NewReader(source))
+	require.NoError(t, err)
+	return doc
+}
+
+func TestParseStaticItems(t *testing.T) {
+	source, err := os.ReadFile("testdata/posts.html")
+	require.NoError(t, err)
+	page := &staticPage{doc: parseFixture(t, string(source)), url: "https://example.com/blog/"}
+
+	task := fixtureTask
+	task.SelectorCreated = "time"
+	require.NoError(t, staticSupported(task))
+	maker := itemMaker{
+		task:       task,
+		dateParser: &dateparser.DateParser{CurrentTimeFunc: time.Now},
+		firstSeen:  dummyfirstseen.New(),
+	}
+	items, err := maker.parseStaticItems(page)
+	require.NoError(t, err)
+	require.Len(t, items, 60)
+
+	item := items[0]
+	assert.Equal(t, "Post number 1", item.Title)
+	assert.Equal(t, "https://example.com/posts/1?utm_source=feed", item.Link)
+	assert.Equal(t, "Summary of post 1 with bold text.", item.Description)
+	assert.Equal(t, "Author 1", item.AuthorName)
+	assert.Equal(t, "https://example.com/authors/1", item.AuthorLink)
+	assert.Equal(t, "https://example.com/covers/1.jpg", item.Enclosure)
+	assert.Equal(t, time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC), item.Created.UTC())
+	assert.Empty(t, item.Id, "hidden elements are empty")
+	assert.Contains(t, item.Content, `<p>First paragraph of post 1. </p><img src="https://example.com/images/1.png"/>`)
+
+	var result models.TaskResult
+	extractStaticMeta(page, &result)
+	assert.Equal(t, "Fixture blog", result.Title)
+	assert.Equal(t, "en", result.Language)
+}
+
+func TestParseStaticStructured(t *testing.T) {
+	page := &staticPage{url: "https://example.com/blog/", doc: parseFixture(t, `<html><body>
+		<article itemscope itemtype="https://schema.org/BlogPosting">
+			<a itemprop="url" href="/posts/1"><h2 itemprop="headline">Post 1</h2></a>
+			<time itemprop="datePublished" datetime="2025-01-02T10:00:00Z">yesterday</time>
+		</article>
+	</body></html>`)}
+	maker := itemMaker{
+		task:       models.Task{StructuredData: models.StructuredDataMode_Only},
+		dateParser: &dateparser.DateParser{CurrentTimeFunc: time.Now},
+		firstSeen:  dummyfirstseen.New(),
+	}
+	require.NoError(t, staticSupported(maker.task))
+	items, err := maker.parseStaticItems(page)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Post 1", items[0].Title)
+	assert.Equal(t, "https://example.com/posts/1", items[0].Link)
+	assert.Equal(t, time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC), items[0].Created.UTC())
+
+	maker.task = fixtureTask
+	maker.task.SelectorCreated = "time"
+	maker.task.SelectorPost = "div.missing"
+	_, err = maker.parseStaticItems(page)
+	assert.Error(t, err, "selectors only")
+
+	maker.task.StructuredData = models.StructuredDataMode_Fallback
+	items, err = maker.parseStaticItems(page)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+}
+
+func TestStaticSupported(t *testing.T) {
+	task := fixtureTask
+	assert.Error(t, staticSupported(task), "xpath needs browser")
+
+	task.SelectorCreated = "time"
+	assert.NoError(t, staticSupported(task))
+
+	withText := task
+	withText.SelectorTitle = "h2:has-text('Post')"
+	assert.Error(t, staticSupported(withText))
+
+	withScroll := task
+	withScroll.ScrollSteps = 3
+	assert.Error(t, staticSupported(withScroll))
+
+	withActions := task
+	withActions.Actions = []models.Action{{Type: models.ActionType_Click, Selector: "button"}}
+	assert.Error(t, staticSupported(withActions))
+}
+
+func TestSelectAll(t *testing.T) {
+	doc := parseFixture(t, `<div id="root"><p id="a"></p><h2 id="b"><p id="c"></p></h2></div>`)
+	root := selectFirst(css.MustParse("#root"), doc)
+	require.NotNil(t, root)
+
+	var ids []string
+	for _, n := range selectAll(css.MustParse("h2, p"), root) {
+		ids = append(ids, getAttr(n, "id"))
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, ids, "document order, no duplicates")
+	assert.Empty(t, selectAll(css.MustParse("div"), root), "root itself is not matched")
+}
+
+func TestInnerText(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{"whitespace", "<div id=x>  Hello\n\t <b>world</b>  </div>", "Hello world"},
+		{"blocks", "<div id=x><p>One</p><p>Two<br>Three</p></div>", "One\nTwo\nThree"},
+		{"hidden", `<div id=x>Shown <span style="display: none">hidden</span><span hidden>too</span></div>`, "Shown"},
+		{"script", "<div id=x>Text<script>var a = 1;</script></div>", "Text"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			el := selectFirst(css.MustParse("#x"), parseFixture(t, tt.source))
+			require.NotNil(t, el)
+			assert.Equal(t, tt.expected, innerText(el))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package pwextractor
+
+import (
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/egor3f/rssalchemy/internal/structured"
+	"github.com/labstack/gommon/log"
+	"golang.org/x/net/html"
+	"strings"
+)
+
+// useSelectors is false when posts are taken only from structured data
+func (p *itemMaker) useSelectors() bool {
+	return p.task.StructuredData != models.StructuredDataMode_Only && len(p.task.SelectorPost) > 0
+}
+
+// structuredItems makes items from JSON-LD, microdata or OpenGraph of document
+func (p *itemMaker) structuredItems(doc *html.Node, baseUrl string) ([]models.FeedItem, error) {
+	found := structured.Extract(doc)
+	if len(found) == 0 {
+		return nil, fmt.Errorf("no structured data on page")
+	}
+	log.Debugf("Structured data items count=%d", len(found))
+	posts := make([]rawPost, len(found))
+	for i, item := range found {
+		raw := make(rawPost)
+		for name, value := range map[string]string{
+			fieldTitle:       item.Headline,
+			fieldLink:        item.Url,
+			fieldDescription: item.Description,
+			fieldAuthor:      item.Author,
+			fieldCreated:     item.DatePublished,
+			fieldUpdated:     item.DateModified,
+			fieldEnclosure:   item.Image,
+		} {
+			if len(value) > 0 {
+				raw[name] = value
+			}
+		}
+		posts[i] = raw
+	}
+	return p.makeItems(posts, baseUrl)
+}
+
+// parseStructured is parseItems by structured data of rendered page
+func (p *pageParser) parseStructured() ([]models.FeedItem, error) {
+	content, err := p.page.Content()
+	if err != nil {
+		return nil, fmt.Errorf("page content: %w", err)
+	}
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("parse html: %w", err)
+	}
+	return p.structuredItems(doc, p.page.URL())
+}
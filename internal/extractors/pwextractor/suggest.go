@@ -0,0 +1,76 @@
+package pwextractor
+
+import (
+	"fmt"
+	dummyfirstseen "github.com/egor3f/rssalchemy/internal/firstseen/dummy"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/egor3f/rssalchemy/internal/suggest"
+	"github.com/labstack/gommon/log"
+	"github.com/playwright-community/playwright-go"
+	"golang.org/x/net/html"
+	"strings"
+	"time"
+)
+
+const (
+	maxSuggestions = 5
+	previewItems   = 5
+)
+
+// SuggestSelectors loads page in browser and finds candidate selectors for posts and their fields.
+// Each candidate is tried on rendered html, ones which extract nothing are dropped
+func (e *PwExtractor) SuggestSelectors(task models.Task) (result *models.SuggestTaskResult, errRet error) {
+	errRet = e.visitPage(task, time.Now().Add(taskBudget), func(page playwright.Page) error {
+		err := page.WaitForLoadState(playwright.PageWaitForLoadStateOptions{
+			State:   playwright.LoadStateNetworkidle,
+			Timeout: pwDuration("5s"),
+		})
+		if err != nil {
+			log.Debugf("Wait for network idle: %v", err)
+		}
+		content, err := page.Content()
+		if err != nil {
+			return fmt.Errorf("page content: %w", err)
+		}
+		doc, err := html.Parse(strings.NewReader(content))
+		if err != nil {
+			return fmt.Errorf("parse html: %w", err)
+		}
+		result = &models.SuggestTaskResult{}
+		for _, suggestion := range suggest.Suggest(doc, maxSuggestions) {
+			suggestion.Preview, err = e.preview(task, suggestion, &staticPage{doc: doc, url: page.URL()})
+			if err != nil {
+				log.Debugf("Suggestion %s dropped: %v", suggestion.SelectorPost, err)
+				continue
+			}
+			result.Suggestions = append(result.Suggestions, suggestion)
+		}
+		log.Infof("Suggest finished, suggestions=%d", len(result.Suggestions))
+		return nil
+	})
+	return
+}
+
+// preview extracts first items with suggested selectors. Previews must not be remembered as seen
+func (e *PwExtractor) preview(task models.Task, s models.SelectorSuggestion, page *staticPage) ([]models.FeedItem, error) {
+	task.SelectorPost = s.SelectorPost
+	task.SelectorTitle = s.SelectorTitle
+	task.SelectorLink = s.SelectorLink
+	task.SelectorDescription = s.SelectorDescription
+	task.SelectorCreated = s.SelectorCreated
+	if len(s.CreatedAttributeName) > 0 {
+		task.CreatedExtractFrom = models.ExtractFrom_Attribute
+		task.CreatedAttributeName = s.CreatedAttributeName
+	}
+	task.SelectorEnclosure = s.SelectorEnclosure
+	maker := itemMaker{
+		task:       task,
+		dateParser: e.dateParser,
+		firstSeen:  dummyfirstseen.New(),
+	}
+	items, err := maker.parseStaticItems(page)
+	if err != nil {
+		return nil, err
+	}
+	return items[:min(len(items), previewItems)], nil
+}
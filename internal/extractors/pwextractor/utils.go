@@ -12,15 +12,25 @@ import (
 )
 
 func absUrl(link string, page playwright.Page) string {
+	return resolveUrl(link, page.URL())
+}
+
+// resolveUrl makes link absolute relative to base url (e.g. "/a", "a", "//host/a")
+// if link or base is invalid, link is returned as is
+func resolveUrl(link string, base string) string {
+	link = strings.TrimSpace(link)
 	if len(link) == 0 {
 		return ""
 	}
-	if strings.HasPrefix(link, "/") {
-		pageUrl, _ := url.Parse(page.URL())
-		link = fmt.Sprintf("%s://%s%s", pageUrl.Scheme, pageUrl.Host, link)
+	linkUrl, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	baseUrl, err := url.Parse(base)
+	if err != nil {
+		return link
 	}
-	//log.Debugf("link=%s", link)
-	return link
+	return baseUrl.ResolveReference(linkUrl).String()
 }
 
 // pwDuration converts string like "10s" to milliseconds float64 pointer
@@ -3,7 +3,9 @@ package pwextractor
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/egor3f/rssalchemy/internal/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -207,3 +209,95 @@ func Test_parseBaseDomain(t *testing.T) {
 		})
 	}
 }
+
+func Test_resolveUrl(t *testing.T) {
+	base := "https://example.com/blog/page?p=2"
+	tests := []struct {
+		link     string
+		expected string
+	}{
+		{"", ""},
+		{"https://other.com/a", "https://other.com/a"},
+		{"/img/a.png", "https://example.com/img/a.png"},
+		{"img/a.png", "https://example.com/blog/img/a.png"},
+		{"//cdn.example.com/a.png", "https://cdn.example.com/a.png"},
+		{"  /trimmed ", "https://example.com/trimmed"},
+		{"?p=3", "https://example.com/blog/page?p=3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.link, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resolveUrl(tt.link, base))
+		})
+	}
+}
+
+func Test_budgetDeadline(t *testing.T) {
+	taskDeadline := time.Now().Add(time.Minute)
+	assert.WithinDuration(t, time.Now().Add(time.Second), budgetDeadline(time.Second, taskDeadline), 100*time.Millisecond)
+	assert.Equal(t, taskDeadline, budgetDeadline(time.Hour, taskDeadline), "stage can't outlive task")
+}
+
+func Test_targetReached(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   int
+		count    int
+		expected bool
+	}{
+		{"no target", 0, 100, false},
+		{"below target", 10, 9, false},
+		{"target", 10, 10, true},
+		{"above target", 10, 11, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, targetReached(models.Task{TargetItems: tt.target}, tt.count))
+		})
+	}
+}
+
+func Test_appendNewItems(t *testing.T) {
+	result := models.TaskResult{Items: []models.FeedItem{
+		{Title: "a", Link: "https://example.com/a"},
+		{Title: "b", Link: "https://example.com/b"},
+	}}
+	seen := seenLinks(result.Items)
+	assert.Len(t, seen, 2)
+
+	added := appendNewItems(&result, seen, []models.FeedItem{
+		{Title: "b again", Link: "https://example.com/b"},
+		{Title: "c", Link: "https://example.com/c"},
+		{Title: "c duplicate", Link: "https://example.com/c"},
+	})
+	assert.Equal(t, 1, added)
+	var titles []string
+	for _, item := range result.Items {
+		titles = append(titles, item.Title)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, titles)
+	assert.Contains(t, seen, "https://example.com/c")
+
+	added = appendNewItems(&result, seen, []models.FeedItem{{Title: "a", Link: "https://example.com/a"}})
+	assert.Zero(t, added, "page with only seen items stops pagination")
+}
+
+func Test_isPageLink(t *testing.T) {
+	tests := []struct {
+		href     string
+		expected bool
+	}{
+		{"", false},
+		{"#", false},
+		{"#page-2", false},
+		{"javascript:void(0)", false},
+		{"JavaScript:next()", false},
+		{"/blog?page=2", true},
+		{"https://example.com/page/2", true},
+		{"?page=2", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.href, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isPageLink(tt.href))
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package dummy
+
+import (
+	"sync"
+	"time"
+)
+
+// Store remembers first seen time in memory, for development purposes
+type Store struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func New() *Store {
+	s := Store{seen: make(map[string]time.Time)}
+	return &s
+}
+
+func (s *Store) FirstSeen(link string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ts, ok := s.seen[link]
+	if !ok {
+		ts = time.Now()
+		s.seen[link] = ts
+	}
+	return ts, nil
+}
@@ -0,0 +1,94 @@
+package nats
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"github.com/labstack/gommon/log"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"time"
+)
+
+const (
+	// Items not seen for this long are forgotten. Bucket TTL counts from last write, so keys are rewritten when read
+	storeTTL = 365 * 24 * time.Hour
+	// Keys are rewritten not more often than this, to not make a write on every item of every extraction
+	refreshInterval = 24 * time.Hour
+)
+
+type Store struct {
+	kv jetstream.KeyValue
+}
+
+func New(natsc *nats.Conn) (*Store, error) {
+	s := Store{}
+
+	jets, err := jetstream.New(natsc)
+	if err != nil {
+		return nil, fmt.Errorf("create jetstream: %w", err)
+	}
+
+	s.kv, err = jets.CreateKeyValue(context.TODO(), jetstream.KeyValueConfig{
+		Bucket: "first_seen_store",
+		TTL:    storeTTL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create nats kv: %w", err)
+	}
+
+	return &s, nil
+}
+
+func (s *Store) FirstSeen(link string) (time.Time, error) {
+	key := s.storeKey(link)
+	entry, err := s.kv.Get(context.TODO(), key)
+	if err == nil {
+		s.refresh(entry)
+		return parseEntry(entry)
+	}
+	if !errors.Is(err, jetstream.ErrKeyNotFound) {
+		return time.Time{}, fmt.Errorf("kv get: %w", err)
+	}
+
+	now := time.Now().UTC()
+	_, err = s.kv.Create(context.TODO(), key, []byte(now.Format(time.RFC3339)))
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		// another worker was faster
+		entry, err := s.kv.Get(context.TODO(), key)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("kv get after create: %w", err)
+		}
+		return parseEntry(entry)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("kv create: %w", err)
+	}
+	return now.Truncate(time.Second), nil
+}
+
+// refresh rewrites the same value, so ttl starts again. It is not an error if another worker did it first
+func (s *Store) refresh(entry jetstream.KeyValueEntry) {
+	if time.Since(entry.Created()) < refreshInterval {
+		return
+	}
+	_, err := s.kv.Update(context.TODO(), entry.Key(), entry.Value(), entry.Revision())
+	if err != nil && !errors.Is(err, jetstream.ErrKeyExists) {
+		log.Warnf("first seen refresh: %v", err)
+	}
+}
+
+func parseEntry(entry jetstream.KeyValueEntry) (time.Time, error) {
+	ts, err := time.Parse(time.RFC3339, string(entry.Value()))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse stored time: %w", err)
+	}
+	return ts, nil
+}
+
+func (s *Store) storeKey(link string) string {
+	hash := sha256.New()
+	hash.Write([]byte(link))
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
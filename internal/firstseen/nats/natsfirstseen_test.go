@@ -0,0 +1,111 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEntry struct {
+	jetstream.KeyValueEntry
+	key      string
+	value    []byte
+	revision uint64
+	created  time.Time
+}
+
+func (e *testEntry) Key() string        { return e.key }
+func (e *testEntry) Value() []byte      { return e.value }
+func (e *testEntry) Revision() uint64   { return e.revision }
+func (e *testEntry) Created() time.Time { return e.created }
+
+// testKV implements only methods used by Store
+type testKV struct {
+	jetstream.KeyValue
+	entries map[string]*testEntry
+	updates int
+	err     error
+}
+
+func (kv *testKV) Get(_ context.Context, key string) (jetstream.KeyValueEntry, error) {
+	if kv.err != nil {
+		return nil, kv.err
+	}
+	entry, ok := kv.entries[key]
+	if !ok {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	return entry, nil
+}
+
+func (kv *testKV) Create(_ context.Context, key string, value []byte) (uint64, error) {
+	if _, ok := kv.entries[key]; ok {
+		return 0, jetstream.ErrKeyExists
+	}
+	kv.entries[key] = &testEntry{key: key, value: value, revision: 1, created: time.Now()}
+	return 1, nil
+}
+
+func (kv *testKV) Update(_ context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	entry, ok := kv.entries[key]
+	if !ok || entry.revision != revision {
+		return 0, jetstream.ErrKeyExists
+	}
+	kv.updates++
+	kv.entries[key] = &testEntry{key: key, value: value, revision: revision + 1, created: time.Now()}
+	return revision + 1, nil
+}
+
+func TestFirstSeen(t *testing.T) {
+	kv := &testKV{entries: make(map[string]*testEntry)}
+	s := Store{kv: kv}
+	link := "https://example.com/post"
+
+	first, err := s.FirstSeen(link)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), first, 2*time.Second)
+
+	again, err := s.FirstSeen(link)
+	require.NoError(t, err)
+	assert.Equal(t, first, again, "time is stable between extractions")
+	assert.Zero(t, kv.updates, "fresh entry is not rewritten")
+
+	other, err := s.FirstSeen("https://example.com/other")
+	require.NoError(t, err)
+	assert.False(t, other.Before(first))
+}
+
+func TestFirstSeenRefresh(t *testing.T) {
+	kv := &testKV{entries: make(map[string]*testEntry)}
+	s := Store{kv: kv}
+	link := "https://example.com/post"
+	seen := time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC)
+	key := s.storeKey(link)
+	kv.entries[key] = &testEntry{
+		key:      key,
+		value:    []byte(seen.Format(time.RFC3339)),
+		revision: 5,
+		created:  time.Now().Add(-2 * refreshInterval),
+	}
+
+	ts, err := s.FirstSeen(link)
+	require.NoError(t, err)
+	assert.Equal(t, seen, ts)
+	assert.Equal(t, 1, kv.updates, "old entry is rewritten, so ttl starts again")
+	assert.Equal(t, []byte(seen.Format(time.RFC3339)), kv.entries[key].Value(), "value is the same")
+
+	_, err = s.FirstSeen(link)
+	require.NoError(t, err)
+	assert.Equal(t, 1, kv.updates)
+}
+
+func TestFirstSeenError(t *testing.T) {
+	s := Store{kv: &testKV{err: errors.New("nats: timeout")}}
+	_, err := s.FirstSeen("https://example.com/post")
+	assert.Error(t, err)
+}
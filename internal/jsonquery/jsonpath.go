@@ -0,0 +1,199 @@
+package jsonquery
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// jsonPath supports subset of JSONPath: $, .name, ['name'], [index], [*], .* and recursive descent (..).
+// Filters and slices are not supported, JMESPath can be used for them
+type jsonPath struct {
+	segments []pathSegment
+	definite bool // path without wildcards returns single value, otherwise list of matches
+}
+
+type pathSegment struct {
+	recursive bool
+	wildcard  bool
+	isIndex   bool
+	name      string
+	index     int
+}
+
+func compileJsonPath(expr string) (*jsonPath, error) {
+	p := jsonPath{definite: true}
+	rest := strings.TrimSpace(expr)
+	if !strings.HasPrefix(rest, "$") {
+		return nil, fmt.Errorf("jsonpath must start with $")
+	}
+	rest = rest[1:]
+	for len(rest) > 0 {
+		var seg pathSegment
+		var err error
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			seg.recursive = true
+			rest = rest[2:]
+			if strings.HasPrefix(rest, "[") {
+				seg, rest, err = parseBracket(rest)
+				seg.recursive = true
+			} else {
+				seg.name, seg.wildcard, rest, err = parseDotName(rest)
+			}
+		case strings.HasPrefix(rest, "."):
+			seg.name, seg.wildcard, rest, err = parseDotName(rest[1:])
+		case strings.HasPrefix(rest, "["):
+			seg, rest, err = parseBracket(rest)
+		default:
+			err = fmt.Errorf("unexpected %q", rest)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("jsonpath %s: %w", expr, err)
+		}
+		if seg.recursive || seg.wildcard {
+			p.definite = false
+		}
+		p.segments = append(p.segments, seg)
+	}
+	return &p, nil
+}
+
+func parseDotName(s string) (name string, wildcard bool, rest string, err error) {
+	end := strings.IndexAny(s, ".[")
+	if end < 0 {
+		end = len(s)
+	}
+	name = s[:end]
+	if len(name) == 0 {
+		return "", false, "", fmt.Errorf("empty name")
+	}
+	return name, name == "*", s[end:], nil
+}
+
+func parseBracket(s string) (pathSegment, string, error) {
+	var seg pathSegment
+	end := -1
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if c == '\'' || c == '"' {
+			quote = c
+		} else if c == ']' {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return seg, "", fmt.Errorf("unclosed bracket")
+	}
+	inner := strings.TrimSpace(s[1:end])
+	rest := s[end+1:]
+	switch {
+	case inner == "*":
+		seg.wildcard = true
+	case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+		name := inner[1 : len(inner)-1]
+		name = strings.ReplaceAll(name, `\`+string(inner[0]), string(inner[0]))
+		seg.name = name
+	case strings.HasPrefix(inner, "?"):
+		return seg, "", fmt.Errorf("filters are not supported, use JMESPath")
+	default:
+		index, err := strconv.Atoi(inner)
+		if err != nil {
+			return seg, "", fmt.Errorf("unsupported bracket expression [%s]", inner)
+		}
+		seg.isIndex = true
+		seg.index = index
+	}
+	return seg, rest, nil
+}
+
+func (p *jsonPath) Search(data any) (any, error) {
+	nodes := []any{data}
+	for _, seg := range p.segments {
+		var next []any
+		for _, node := range nodes {
+			if seg.recursive {
+				for _, n := range descendants(node) {
+					next = append(next, seg.apply(n)...)
+				}
+			} else {
+				next = append(next, seg.apply(node)...)
+			}
+		}
+		nodes = next
+	}
+	if !p.definite {
+		return nodes, nil
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	return nodes[0], nil
+}
+
+func (seg pathSegment) apply(node any) []any {
+	switch v := node.(type) {
+	case map[string]any:
+		if seg.wildcard {
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys) // maps have no order, at least make it stable
+			result := make([]any, len(keys))
+			for i, key := range keys {
+				result[i] = v[key]
+			}
+			return result
+		}
+		if value, ok := v[seg.name]; ok && !seg.isIndex {
+			return []any{value}
+		}
+	case []any:
+		if seg.wildcard {
+			return v
+		}
+		if seg.isIndex {
+			index := seg.index
+			if index < 0 {
+				index += len(v)
+			}
+			if index >= 0 && index < len(v) {
+				return []any{v[index]}
+			}
+		}
+	}
+	return nil
+}
+
+// descendants returns node and all nested values, object keys are visited in sorted order
+func descendants(node any) []any {
+	result := []any{node}
+	switch v := node.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			result = append(result, descendants(v[key])...)
+		}
+	case []any:
+		for _, item := range v {
+			result = append(result, descendants(item)...)
+		}
+	}
+	return result
+}
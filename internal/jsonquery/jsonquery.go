@@ -0,0 +1,132 @@
+package jsonquery
+
+import (
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/jmespath/go-jmespath"
+	"github.com/labstack/gommon/log"
+	"strconv"
+	"time"
+)
+
+// Query is compiled JSONPath or JMESPath expression.
+// Data is a value decoded by encoding/json into any
+type Query interface {
+	Search(data any) (any, error)
+}
+
+func Compile(language models.JsonQueryLanguage, expr string) (Query, error) {
+	switch language {
+	case models.JsonQueryLanguage_JsonPath:
+		return compileJsonPath(expr)
+	case models.JsonQueryLanguage_JmesPath:
+		q, err := jmespath.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("jmespath: %w", err)
+		}
+		return q, nil
+	default:
+		return nil, fmt.Errorf("unknown query language %d", language)
+	}
+}
+
+// Mapping is compiled models.JsonMapping
+type Mapping struct {
+	items  Query
+	fields map[string]Query // keys are field names used by transforms: title, link, ...
+}
+
+func CompileMapping(m models.JsonMapping) (*Mapping, error) {
+	items, err := Compile(m.Language, m.Items)
+	if err != nil {
+		return nil, fmt.Errorf("items: %w", err)
+	}
+	result := Mapping{items: items, fields: make(map[string]Query)}
+	for name, expr := range map[string]string{
+		"title":       m.Title,
+		"link":        m.Link,
+		"description": m.Description,
+		"content":     m.Content,
+		"author":      m.Author,
+		"created":     m.Created,
+		"enclosure":   m.Enclosure,
+	} {
+		if len(expr) == 0 {
+			continue
+		}
+		result.fields[name], err = Compile(m.Language, expr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return &result, nil
+}
+
+// Items returns array found by items query.
+// JSONPath with wildcard returns list of matches, then single match may be the array itself
+func (m *Mapping) Items(data any) ([]any, error) {
+	result, err := m.items.Search(data)
+	if err != nil {
+		return nil, fmt.Errorf("items: %w", err)
+	}
+	items, ok := result.([]any)
+	if !ok {
+		return nil, fmt.Errorf("items query result is not array")
+	}
+	if len(items) == 1 {
+		if inner, ok := items[0].([]any); ok {
+			return inner, nil
+		}
+	}
+	return items, nil
+}
+
+// Values returns mapped fields of item as strings, numeric dates are treated as unix timestamps.
+// Failed queries give empty values
+func (m *Mapping) Values(item any) map[string]string {
+	values := make(map[string]string, len(m.fields))
+	for name, query := range m.fields {
+		result, err := query.Search(item)
+		if err != nil {
+			log.Debugf("json query %s: %v", name, err)
+		}
+		if name == "created" {
+			values[name] = dateString(result)
+		} else {
+			values[name] = toString(result)
+		}
+	}
+	return values
+}
+
+// toString converts scalar to string, for arrays first element is used
+func toString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		if len(v) > 0 {
+			return toString(v[0])
+		}
+	}
+	return ""
+}
+
+// dateString formats unix timestamp in seconds or milliseconds for date parser
+func dateString(value any) string {
+	if list, ok := value.([]any); ok && len(list) > 0 {
+		value = list[0]
+	}
+	ts, ok := value.(float64)
+	if !ok {
+		return toString(value)
+	}
+	if ts > 1e11 {
+		return time.UnixMilli(int64(ts)).UTC().Format(time.RFC3339)
+	}
+	return time.Unix(int64(ts), 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,127 @@
+package jsonquery
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testResponse = `{
+	"data": {
+		"posts": [
+			{"title": "First", "url": "/p/1", "published": 1735812000, "author": {"name": "Ann"}, "tags": ["a", "b"]},
+			{"title": "Second", "url": "/p/2", "published": 1735898400000, "author": {"name": "Bob"}, "tags": []}
+		]
+	},
+	"meta": {"page.size": 2}
+}`
+
+func decodeTestResponse(t *testing.T) any {
+	var data any
+	require.NoError(t, json.Unmarshal([]byte(testResponse), &data))
+	return data
+}
+
+func TestJsonPath(t *testing.T) {
+	data := decodeTestResponse(t)
+	tests := []struct {
+		expr     string
+		expected any
+	}{
+		{"$.data.posts[0].title", "First"},
+		{"$['data']['posts'][-1]['title']", "Second"},
+		{"$.data.posts[*].title", []any{"First", "Second"}},
+		{"$..name", []any{"Ann", "Bob"}},
+		{"$.data.posts[0].tags.*", []any{"a", "b"}},
+		{`$.meta["page.size"]`, float64(2)},
+		{"$.data.missing", nil},
+		{"$.data.posts[5]", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			q, err := Compile(models.JsonQueryLanguage_JsonPath, tt.expr)
+			require.NoError(t, err)
+			result, err := q.Search(data)
+			require.NoError(t, err)
+			if list, ok := result.([]any); ok && tt.expected == nil {
+				assert.Empty(t, list)
+				return
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, expr := range []string{"data.posts", "$.data[", "$.data[?(@.x)]", "$.data[1:2]", "$..", "$x"} {
+		_, err := Compile(models.JsonQueryLanguage_JsonPath, expr)
+		assert.Error(t, err, expr)
+	}
+	_, err := Compile(models.JsonQueryLanguage_JmesPath, "data.posts[?")
+	assert.Error(t, err)
+	_, err = Compile(100, "$")
+	assert.Error(t, err)
+}
+
+func TestMapping(t *testing.T) {
+	data := decodeTestResponse(t)
+	for _, tt := range []struct {
+		name    string
+		mapping models.JsonMapping
+	}{
+		{"jsonpath", models.JsonMapping{
+			Language: models.JsonQueryLanguage_JsonPath,
+			Items:    "$.data.posts[*]",
+			Title:    "$.title",
+			Link:     "$.url",
+			Author:   "$.author.name",
+			Created:  "$.published",
+			Content:  "$.tags",
+		}},
+		{"jsonpath array", models.JsonMapping{
+			Language: models.JsonQueryLanguage_JsonPath,
+			Items:    "$.data.posts",
+			Title:    "$.title",
+			Link:     "$.url",
+			Author:   "$..name",
+			Created:  "$.published",
+			Content:  "$.tags",
+		}},
+		{"jmespath", models.JsonMapping{
+			Language: models.JsonQueryLanguage_JmesPath,
+			Items:    "data.posts",
+			Title:    "title",
+			Link:     "url",
+			Author:   "author.name",
+			Created:  "published",
+			Content:  "tags",
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := CompileMapping(tt.mapping)
+			require.NoError(t, err)
+			items, err := m.Items(data)
+			require.NoError(t, err)
+			require.Len(t, items, 2)
+
+			assert.Equal(t, map[string]string{
+				"title":   "First",
+				"link":    "/p/1",
+				"author":  "Ann",
+				"created": "2025-01-02T10:00:00Z",
+				"content": "a",
+			}, m.Values(items[0]))
+			second := m.Values(items[1])
+			assert.Equal(t, "2025-01-03T10:00:00Z", second["created"], "milliseconds")
+			assert.Equal(t, "", second["content"])
+		})
+	}
+
+	m, err := CompileMapping(models.JsonMapping{Items: "$.data", Title: "$.title", Link: "$.url"})
+	require.NoError(t, err)
+	_, err = m.Items(data)
+	assert.Error(t, err, "object is not items array")
+}
@@ -8,6 +8,6 @@ import (
 type Limiter struct {
 }
 
-func (l *Limiter) Limit(context.Context, string) (time.Duration, error) {
+func (l *Limiter) Limit(context.Context, string, time.Duration) (time.Duration, error) {
 	return 0, nil
 }
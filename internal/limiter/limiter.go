@@ -9,5 +9,7 @@ import (
 var ErrLimitReached = fmt.Errorf("limit reached")
 
 type Limiter interface {
-	Limit(ctx context.Context, key string) (waitFor time.Duration, err error)
+	// Limit reserves slot for key and returns time to wait for it.
+	// If wait is longer than maxWait (0 means no limit), slot is not reserved and ErrLimitReached is returned
+	Limit(ctx context.Context, key string, maxWait time.Duration) (waitFor time.Duration, err error)
 }
@@ -39,28 +39,47 @@ func New(
 	return &l
 }
 
-func (l *Limiter) Limit(ctx context.Context, key string) (time.Duration, error) {
+func (l *Limiter) Limit(ctx context.Context, key string, maxWait time.Duration) (time.Duration, error) {
 	limiterKey := fmt.Sprintf("limiter_%s_%s", l.prefix, key)
 	bucket := limiters.NewLeakyBucket(
 		l.capacity,
 		l.rate,
 		limiters.NewLockRedis(l.redisPool, fmt.Sprintf("%s_lock", limiterKey)),
-		limiters.NewLeakyBucketRedis(
-			l.redisClient,
-			fmt.Sprintf("%s_state", limiterKey),
-			time.Duration(l.capacity*int64(l.rate)),
-			true,
-		),
+		maxWaitBackend{
+			LeakyBucketStateBackend: limiters.NewLeakyBucketRedis(
+				l.redisClient,
+				fmt.Sprintf("%s_state", limiterKey),
+				time.Duration(l.capacity*int64(l.rate)),
+				true,
+			),
+			maxWait: maxWait,
+		},
 		limiters.NewSystemClock(),
 		logger{},
 	)
 	wait, err := bucket.Limit(ctx)
-	if errors.Is(err, limiters.ErrLimitExhausted) {
+	if errors.Is(err, limiters.ErrLimitExhausted) || errors.Is(err, errMaxWait) {
 		err = limiter.ErrLimitReached // My own sentinel error not to depend on `mennanov/limiters` library
 	}
 	return wait, err
 }
 
+var errMaxWait = errors.New("max wait exceeded")
+
+// maxWaitBackend does not save state of request which would wait longer than maxWait,
+// so its slot is not taken. Bucket saves state under lock, only when request is allowed
+type maxWaitBackend struct {
+	limiters.LeakyBucketStateBackend
+	maxWait time.Duration
+}
+
+func (b maxWaitBackend) SetState(ctx context.Context, state limiters.LeakyBucketState) error {
+	if b.maxWait > 0 && time.Until(time.Unix(0, state.Last)) > b.maxWait {
+		return errMaxWait
+	}
+	return b.LeakyBucketStateBackend.SetState(ctx, state)
+}
+
 type logger struct {
 }
 
@@ -0,0 +1,39 @@
+package redisleaky
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mennanov/limiters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxWaitBackend(t *testing.T) {
+	ctx := context.Background()
+	state := limiters.NewLeakyBucketInMemory()
+	bucket := func(maxWait time.Duration) *limiters.LeakyBucket {
+		return limiters.NewLeakyBucket(
+			10,
+			time.Second,
+			limiters.NewLockNoop(),
+			maxWaitBackend{LeakyBucketStateBackend: state, maxWait: maxWait},
+			limiters.NewSystemClock(),
+			logger{},
+		)
+	}
+
+	_, err := bucket(0).Limit(ctx)
+	require.NoError(t, err)
+	wait, err := bucket(0).Limit(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Second, wait, float64(100*time.Millisecond))
+
+	_, err = bucket(time.Second).Limit(ctx)
+	assert.ErrorIs(t, err, errMaxWait, "third request waits 2s")
+
+	wait, err = bucket(5 * time.Second).Limit(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, 2*time.Second, wait, float64(100*time.Millisecond), "refused request did not take slot")
+}
@@ -11,35 +11,217 @@ type TaskType string
 const (
 	TaskTypeExtract        = "extract"
 	TaskTypePageScreenshot = "page_screenshot"
+	TaskTypeJsonApi        = "json_api"
+	TaskTypeSuggest        = "suggest"
+	TaskTypePageSnapshot   = "page_snapshot"
+	TaskTypeDebug          = "debug_screenshot"
 )
 
+// ExtractFrom is how field value is read from element.
+// For Task.LinkExtractFrom and Task.EnclosureExtractFrom InnerText means default attribute, href or src
 type ExtractFrom int
 
 const (
-	ExtractFrom_InnerText ExtractFrom = 0
-	ExtractFrom_Attribute ExtractFrom = 1
+	ExtractFrom_InnerText   ExtractFrom = 0
+	ExtractFrom_Attribute   ExtractFrom = 1
+	ExtractFrom_InnerHtml   ExtractFrom = 2
+	ExtractFrom_TextContent ExtractFrom = 3
 )
 
+// SelectorDialect is a language of selector. Webserver converts all selectors
+// to playwright dialect, so worker does not need it
+type SelectorDialect int
+
+const (
+	SelectorDialect_Css        SelectorDialect = 0
+	SelectorDialect_XPath      SelectorDialect = 1
+	SelectorDialect_Playwright SelectorDialect = 2
+)
+
+type IdStrategy int
+
+const (
+	IdStrategy_LinkDate   IdStrategy = 0
+	IdStrategy_LinkHash   IdStrategy = 1
+	IdStrategy_Selector   IdStrategy = 2
+	IdStrategy_FieldsHash IdStrategy = 3
+)
+
+type FullTextMode int
+
+const (
+	FullTextMode_Off        FullTextMode = 0
+	FullTextMode_BySelector FullTextMode = 1
+	FullTextMode_Auto       FullTextMode = 2
+)
+
+// RenderMode is how page is loaded, see pwextractor.PwExtractor.ExtractStatic
+type RenderMode int
+
+const (
+	RenderMode_Browser RenderMode = 0
+	RenderMode_Static  RenderMode = 1
+	RenderMode_Auto    RenderMode = 2 // static with fallback to browser
+)
+
+// StructuredDataMode is whether posts are taken from JSON-LD, microdata and OpenGraph instead of selectors
+type StructuredDataMode int
+
+const (
+	StructuredDataMode_Off      StructuredDataMode = 0
+	StructuredDataMode_Fallback StructuredDataMode = 1 // used when selectors find no posts
+	StructuredDataMode_Only     StructuredDataMode = 2 // selectors are not needed
+)
+
+type JsonQueryLanguage int
+
+const (
+	JsonQueryLanguage_JsonPath JsonQueryLanguage = 0
+	JsonQueryLanguage_JmesPath JsonQueryLanguage = 1
+)
+
+// JsonMapping describes request to json api and how response is mapped to feed items.
+// Items query is run on response, other queries on each item
+type JsonMapping struct {
+	Method      string // GET or POST
+	Body        string // sent as application/json
+	Language    JsonQueryLanguage
+	Items       string
+	Title       string
+	Link        string
+	Description string
+	Content     string
+	Author      string
+	Created     string
+	Enclosure   string
+}
+
+type ActionType int
+
+const (
+	ActionType_Click           ActionType = 0
+	ActionType_Fill            ActionType = 1
+	ActionType_Press           ActionType = 2
+	ActionType_WaitForSelector ActionType = 3
+	ActionType_WaitForTimeout  ActionType = 4
+	ActionType_SelectOption    ActionType = 5
+)
+
+func (t ActionType) String() string {
+	return map[ActionType]string{
+		ActionType_Click:           "click",
+		ActionType_Fill:            "fill",
+		ActionType_Press:           "press",
+		ActionType_WaitForSelector: "wait for selector",
+		ActionType_WaitForTimeout:  "wait for timeout",
+		ActionType_SelectOption:    "select option",
+	}[t]
+}
+
+// Action is performed on page after it is loaded and before extraction
+type Action struct {
+	Type     ActionType
+	Selector string
+	Value    string        // text for Fill, key for Press, option value for SelectOption
+	Timeout  time.Duration // for WaitForTimeout it is the time to wait
+	Optional bool          // failure of optional action is ignored
+}
+
+const (
+	// DefaultActionTimeout is used when Action.Timeout is not set
+	DefaultActionTimeout = 5 * time.Second
+	// MaxActionsTime is the limit of sum of action timeouts, the whole task has to fit into one minute
+	MaxActionsTime = 20 * time.Second
+)
+
+// MaxTime is the longest time action can take
+func (a Action) MaxTime() time.Duration {
+	if a.Timeout == 0 {
+		return DefaultActionTimeout
+	}
+	return a.Timeout
+}
+
+type TransformType int
+
+const (
+	TransformType_RegexExtract TransformType = 0
+	TransformType_RegexReplace TransformType = 1
+	TransformType_Trim         TransformType = 2
+	TransformType_Lowercase    TransformType = 3
+	TransformType_Uppercase    TransformType = 4
+	TransformType_Prefix       TransformType = 5
+	TransformType_Suffix       TransformType = 6
+	TransformType_Template     TransformType = 7
+)
+
+// Transform changes extracted field value (title, link, description, author, content, created, enclosure)
+type Transform struct {
+	Field   string
+	Type    TransformType
+	Pattern string // regex for RegexExtract and RegexReplace
+	Value   string // replacement, prefix, suffix or Go template
+}
+
 type Task struct {
 	// While adding new fields, dont forget to alter caching func
-	TaskType             TaskType
-	URL                  string
-	SelectorPost         string
-	SelectorTitle        string
-	SelectorLink         string
-	SelectorDescription  string
-	SelectorAuthor       string
-	SelectorCreated      string
-	CreatedExtractFrom   ExtractFrom
-	CreatedAttributeName string
-	SelectorContent      string
-	SelectorEnclosure    string
-	Headers              map[string]string
+	TaskType                 TaskType
+	URL                      string
+	RenderMode               RenderMode
+	StructuredData           StructuredDataMode
+	SelectorPost             string
+	SelectorTitle            string
+	TitleExtractFrom         ExtractFrom
+	TitleAttributeName       string
+	SelectorLink             string
+	LinkExtractFrom          ExtractFrom
+	LinkAttributeName        string
+	SelectorDescription      string
+	DescriptionExtractFrom   ExtractFrom
+	DescriptionAttributeName string
+	SelectorAuthor           string
+	AuthorExtractFrom        ExtractFrom
+	AuthorAttributeName      string
+	SelectorCreated          string
+	CreatedExtractFrom       ExtractFrom
+	CreatedAttributeName     string
+	SelectorUpdated          string
+	UpdatedExtractFrom       ExtractFrom
+	UpdatedAttributeName     string
+	SelectorContent          string
+	SelectorEnclosure        string
+	EnclosureExtractFrom     ExtractFrom
+	EnclosureAttributeName   string
+	SelectorId               string
+	IdAttributeName          string
+	FullTextMode             FullTextMode
+	SelectorFullText         string
+	SelectorNextPage         string
+	MaxPages                 int
+	ScrollSteps              int
+	SelectorLoadMore         string
+	TargetItems              int // stop loading more posts when reached, 0 - no limit
+	Actions                  []Action
+	Transforms               []Transform
+	Json                     JsonMapping // only for TaskTypeJsonApi
+	Headers                  map[string]string
+
+	// Fields used only by webserver, they don't affect extraction
+	IdStrategy   IdStrategy
+	IdFields     []string
+	FeedTitle    string // overrides page title if not empty
+	FeedSubtitle string // overrides page description if not empty
 }
 
 func (t Task) CacheKey() string {
 	h := sha256.New()
 	h.Write([]byte(t.URL))
+	if t.RenderMode != RenderMode_Browser {
+		h.Write([]byte(fmt.Sprintf("render%d", t.RenderMode)))
+	}
+	if t.StructuredData != StructuredDataMode_Off {
+		h.Write([]byte(fmt.Sprintf("structured%d", t.StructuredData)))
+	}
 	h.Write([]byte(t.SelectorPost))
 	h.Write([]byte(t.SelectorTitle))
 	h.Write([]byte(t.SelectorLink))
@@ -48,11 +230,57 @@ func (t Task) CacheKey() string {
 	h.Write([]byte(t.SelectorCreated))
 	h.Write([]byte(t.SelectorContent))
 	h.Write([]byte(t.SelectorEnclosure))
+	h.Write([]byte(t.SelectorId))
+	if len(t.SelectorUpdated) > 0 {
+		h.Write([]byte("updated" + t.SelectorUpdated))
+	}
+	h.Write([]byte(t.IdAttributeName))
+	// extraction modes are written only if changed from defaults, so keys of existing feeds stay the same
+	for _, field := range []struct {
+		name      string
+		from      ExtractFrom
+		attribute string
+	}{
+		{"title", t.TitleExtractFrom, t.TitleAttributeName},
+		{"link", t.LinkExtractFrom, t.LinkAttributeName},
+		{"description", t.DescriptionExtractFrom, t.DescriptionAttributeName},
+		{"author", t.AuthorExtractFrom, t.AuthorAttributeName},
+		{"created", t.CreatedExtractFrom, t.CreatedAttributeName},
+		{"updated", t.UpdatedExtractFrom, t.UpdatedAttributeName},
+		{"enclosure", t.EnclosureExtractFrom, t.EnclosureAttributeName},
+	} {
+		if field.from != ExtractFrom_InnerText || len(field.attribute) > 0 {
+			h.Write([]byte(fmt.Sprintf("%s%d%s", field.name, field.from, field.attribute)))
+		}
+	}
+	if t.FullTextMode != FullTextMode_Off {
+		h.Write([]byte(fmt.Sprintf("fulltext%d", t.FullTextMode)))
+	}
+	h.Write([]byte(t.SelectorFullText))
+	if len(t.SelectorNextPage) > 0 {
+		h.Write([]byte(fmt.Sprintf("%s%d", t.SelectorNextPage, t.MaxPages)))
+	}
+	if t.ScrollSteps > 0 || len(t.SelectorLoadMore) > 0 {
+		h.Write([]byte(fmt.Sprintf("%d%s", t.ScrollSteps, t.SelectorLoadMore)))
+	}
+	if t.TargetItems > 0 {
+		h.Write([]byte(fmt.Sprintf("target%d", t.TargetItems)))
+	}
+	if len(t.Actions) > 0 {
+		h.Write([]byte(fmt.Sprintf("%+v", t.Actions)))
+	}
+	if len(t.Transforms) > 0 {
+		h.Write([]byte(fmt.Sprintf("%+v", t.Transforms)))
+	}
+	if t.TaskType == TaskTypeJsonApi {
+		h.Write([]byte(fmt.Sprintf("%+v", t.Json)))
+	}
 	h.Write([]byte(fmt.Sprintf("%+v", t.Headers)))
 	return fmt.Sprintf("%s_%x", t.TaskType, h.Sum(nil))
 }
 
 type FeedItem struct {
+	Id          string // extracted using Task.SelectorId, empty if not used
 	Title       string
 	Created     time.Time
 	Updated     time.Time
@@ -62,14 +290,71 @@ type FeedItem struct {
 	Content     string
 	Enclosure   string
 	AuthorLink  string
+	// EnclosureType is MIME type, EnclosureLength is size in bytes (0 if unknown)
+	EnclosureType   string
+	EnclosureLength int64
 }
 
 type TaskResult struct {
-	Title string
-	Items []FeedItem
-	Icon  string
+	Title       string
+	Items       []FeedItem
+	Icon        string
+	Description string // from meta description or og:description
+	Language    string // from html lang attribute
+	Image       string // from og:image, used as feed logo
 }
 
 type ScreenshotTaskResult struct {
 	Image []byte // png
 }
+
+// SelectorReport is how many elements selector matched, field selectors are run inside each post
+type SelectorReport struct {
+	Field    string // post, field name, next_page or load_more
+	Selector string
+	Matches  int    // total matched elements
+	Posts    int    // posts where field was found, only for fields
+	Error    string // e.g. invalid selector
+}
+
+type DebugTaskResult struct {
+	Image     []byte // png with outlined matches
+	Selectors []SelectorReport
+	Items     []FeedItem // extracted posts, empty if extraction failed
+}
+
+// SnapshotElement is visible element of page screenshot
+type SnapshotElement struct {
+	Tag             string
+	Id              string
+	Classes         []string
+	Text            string // beginning of text content
+	X, Y            int
+	Width, Height   int
+	Selector        string // matches only this element
+	GeneralSelector string // matches similar elements, e.g. all posts of list
+}
+
+type SnapshotTaskResult struct {
+	Image    []byte // png
+	Width    int    // viewport size, image has the same size
+	Height   int
+	Elements []SnapshotElement // in document order, so parents go before children
+}
+
+// SelectorSuggestion is candidate set of css selectors found by heuristics, field selectors are relative to post
+type SelectorSuggestion struct {
+	Score                float64
+	SelectorPost         string
+	SelectorTitle        string
+	SelectorLink         string
+	SelectorDescription  string
+	SelectorCreated      string
+	CreatedAttributeName string // datetime for time elements, empty for inner text
+	SelectorEnclosure    string
+	Preview              []FeedItem // first items extracted with these selectors
+}
+
+type SuggestTaskResult struct {
+	Suggestions []SelectorSuggestion // best first
+}
@@ -0,0 +1,292 @@
+package sanitizer
+
+import (
+	"fmt"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// urlAttrs are attributes with url values. Urls are resolved and their schemes are checked, wherever attribute is allowed
+var urlAttrs = map[string]bool{
+	"action":     true,
+	"background": true,
+	"cite":       true,
+	"codebase":   true,
+	"data":       true,
+	"formaction": true,
+	"href":       true,
+	"icon":       true,
+	"longdesc":   true,
+	"manifest":   true,
+	"poster":     true,
+	"src":        true,
+	"usemap":     true,
+}
+
+// urlListAttrs have several urls: srcset is comma separated urls with descriptors, ping is space separated urls
+var urlListAttrs = map[string]bool{
+	"srcset": true,
+	"ping":   true,
+}
+
+// Policy is an allowlist: tags not listed here are unwrapped (their children are kept),
+// attributes not listed for the tag are removed
+type Policy struct {
+	// Tag name -> allowed attribute names
+	Tags map[string][]string
+	// Tags removed together with their content
+	DropTags []string
+	// Allowed schemes of href and src
+	UrlSchemes []string
+}
+
+// DefaultPolicy allows basic text formatting, links, images, lists and tables
+func DefaultPolicy() Policy {
+	tags := map[string][]string{
+		"a":          {"href", "title"},
+		"blockquote": {"cite"},
+		"img":        {"src", "alt", "title", "width", "height"},
+		"ol":         {"start"},
+		"q":          {"cite"},
+		"td":         {"colspan", "rowspan"},
+		"th":         {"colspan", "rowspan", "scope"},
+		"time":       {"datetime"},
+	}
+	for _, tag := range []string{
+		"abbr", "b", "br", "caption", "cite", "code", "dd", "del", "details", "dfn", "div", "dl", "dt",
+		"em", "figcaption", "figure", "h1", "h2", "h3", "h4", "h5", "h6", "hr", "i", "ins", "kbd", "li",
+		"mark", "p", "pre", "s", "samp", "small", "span", "strong", "sub", "summary", "sup", "table",
+		"tbody", "tfoot", "thead", "tr", "u", "ul", "var",
+	} {
+		tags[tag] = nil
+	}
+	return Policy{
+		Tags: tags,
+		DropTags: []string{
+			"script", "style", "noscript", "template", "iframe", "frame", "frameset", "object", "embed",
+			"applet", "svg", "math", "form", "input", "button", "select", "textarea", "head", "title",
+			"meta", "link", "base",
+		},
+		UrlSchemes: []string{"http", "https", "mailto"},
+	}
+}
+
+// Allow adds tags and attributes to policy. Entries are tag names or tag.attribute pairs,
+// e.g. "video", "video.src". Allowed tags are removed from DropTags.
+// Event handlers and style can run scripts or hide content, so they can't be allowed
+func (p *Policy) Allow(entries []string) error {
+	for _, entry := range entries {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		tag, attr, hasAttr := strings.Cut(entry, ".")
+		if len(tag) == 0 {
+			continue
+		}
+		if strings.HasPrefix(attr, "on") || attr == "style" {
+			return fmt.Errorf("attribute %s can't be allowed", entry)
+		}
+		attrs := p.Tags[tag]
+		if hasAttr && len(attr) > 0 {
+			attrs = append(attrs, attr)
+		}
+		p.Tags[tag] = attrs
+		p.DropTags = slices.DeleteFunc(p.DropTags, func(dropTag string) bool {
+			return dropTag == tag
+		})
+	}
+	return nil
+}
+
+// Deny removes tags from policy, so they are unwrapped
+func (p *Policy) Deny(tags []string) {
+	for _, tag := range tags {
+		delete(p.Tags, strings.ToLower(strings.TrimSpace(tag)))
+	}
+}
+
+type Sanitizer struct {
+	tags       map[string]map[string]bool
+	dropTags   map[string]bool
+	urlSchemes map[string]bool
+}
+
+func New(policy Policy) *Sanitizer {
+	s := Sanitizer{
+		tags:       make(map[string]map[string]bool, len(policy.Tags)),
+		dropTags:   make(map[string]bool, len(policy.DropTags)),
+		urlSchemes: make(map[string]bool, len(policy.UrlSchemes)),
+	}
+	for tag, attrs := range policy.Tags {
+		s.tags[tag] = make(map[string]bool, len(attrs))
+		for _, attr := range attrs {
+			s.tags[tag][attr] = true
+		}
+	}
+	for _, tag := range policy.DropTags {
+		s.dropTags[tag] = true
+	}
+	for _, scheme := range policy.UrlSchemes {
+		s.urlSchemes[scheme] = true
+	}
+	return &s
+}
+
+// Sanitize html fragment. Relative urls are resolved against baseUrl
+func (s *Sanitizer) Sanitize(fragment string, baseUrl string) (string, error) {
+	base, err := url.Parse(baseUrl)
+	if err != nil {
+		return "", fmt.Errorf("parse base url: %w", err)
+	}
+	body := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), body)
+	if err != nil {
+		return "", fmt.Errorf("parse html: %w", err)
+	}
+	for _, node := range nodes {
+		body.AppendChild(node)
+	}
+	s.sanitizeChildren(body, base)
+
+	var sb strings.Builder
+	for node := body.FirstChild; node != nil; node = node.NextSibling {
+		if err := html.Render(&sb, node); err != nil {
+			return "", fmt.Errorf("render html: %w", err)
+		}
+	}
+	return sb.String(), nil
+}
+
+func (s *Sanitizer) sanitizeChildren(parent *html.Node, base *url.URL) {
+	node := parent.FirstChild
+	for node != nil {
+		next := node.NextSibling
+		switch node.Type {
+		case html.TextNode:
+			// kept as is
+		case html.ElementNode:
+			tag := strings.ToLower(node.Data)
+			allowedAttrs, allowed := s.tags[tag]
+			switch {
+			case s.dropTags[tag]:
+				parent.RemoveChild(node)
+			case !allowed:
+				// unwrap: children are sanitized and moved in place of node, then loop continues after them
+				s.sanitizeChildren(node, base)
+				for child := node.FirstChild; child != nil; child = node.FirstChild {
+					node.RemoveChild(child)
+					parent.InsertBefore(child, node)
+				}
+				parent.RemoveChild(node)
+			default:
+				if tag == "img" && isTrackingPixel(node) {
+					parent.RemoveChild(node)
+					break
+				}
+				s.sanitizeAttrs(node, allowedAttrs, base)
+				if tag == "img" && len(getAttr(node, "src")) == 0 {
+					parent.RemoveChild(node)
+					break
+				}
+				s.sanitizeChildren(node, base)
+			}
+		default:
+			// comments, doctypes
+			parent.RemoveChild(node)
+		}
+		node = next
+	}
+}
+
+func (s *Sanitizer) sanitizeAttrs(node *html.Node, allowedAttrs map[string]bool, base *url.URL) {
+	attrs := node.Attr[:0]
+	for _, attr := range node.Attr {
+		key := strings.ToLower(attr.Key)
+		if len(attr.Namespace) > 0 || !allowedAttrs[key] {
+			continue
+		}
+		if urlAttrs[key] {
+			absUrl, ok := s.absUrl(attr.Val, base)
+			if !ok {
+				continue
+			}
+			attr.Val = absUrl
+		}
+		if urlListAttrs[key] {
+			list, ok := s.absUrlList(key, attr.Val, base)
+			if !ok {
+				continue
+			}
+			attr.Val = list
+		}
+		attr.Key = key
+		attrs = append(attrs, attr)
+	}
+	node.Attr = attrs
+	if node.DataAtom == atom.A && len(getAttr(node, "href")) > 0 {
+		node.Attr = append(node.Attr, html.Attribute{Key: "rel", Val: "noopener"})
+	}
+}
+
+// absUrl resolves link and checks its scheme
+func (s *Sanitizer) absUrl(link string, base *url.URL) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return "", false
+	}
+	u = base.ResolveReference(u)
+	if !s.urlSchemes[u.Scheme] {
+		return "", false
+	}
+	return u.String(), true
+}
+
+// absUrlList resolves every url of srcset or ping, attribute is dropped if any url is not allowed
+func (s *Sanitizer) absUrlList(key string, value string, base *url.URL) (string, bool) {
+	if key == "ping" {
+		var urls []string
+		for _, link := range strings.Fields(value) {
+			absUrl, ok := s.absUrl(link, base)
+			if !ok {
+				return "", false
+			}
+			urls = append(urls, absUrl)
+		}
+		return strings.Join(urls, " "), len(urls) > 0
+	}
+	var candidates []string
+	for _, candidate := range strings.Split(value, ",") {
+		fields := strings.Fields(candidate)
+		if len(fields) == 0 {
+			continue
+		}
+		absUrl, ok := s.absUrl(fields[0], base)
+		if !ok {
+			return "", false
+		}
+		candidates = append(candidates, strings.Join(append([]string{absUrl}, fields[1:]...), " "))
+	}
+	return strings.Join(candidates, ", "), len(candidates) > 0
+}
+
+// isTrackingPixel detects images with size of 1px or less
+func isTrackingPixel(node *html.Node) bool {
+	for _, name := range []string{"width", "height"} {
+		value := strings.TrimSuffix(strings.TrimSpace(getAttr(node, name)), "px")
+		if size, err := strconv.ParseFloat(value, 64); err == nil && size <= 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func getAttr(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
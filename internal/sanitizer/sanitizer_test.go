@@ -0,0 +1,96 @@
+package sanitizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"plain text", `a < b & c`, `a &lt; b &amp; c`},
+		{"allowed tags", `<p>Hello <b>world</b></p>`, `<p>Hello <b>world</b></p>`},
+		{"script removed", `<p>a</p><script>alert(1)</script><style>p{}</style>`, `<p>a</p>`},
+		{"unknown tag unwrapped", `<section><p>a</p><custom>b</custom></section>`, `<p>a</p>b`},
+		{"event handlers removed", `<p onclick="alert(1)" class="x" style="color:red">a</p>`, `<p>a</p>`},
+		{
+			"relative link",
+			`<a href="/post?id=1" target="_blank">link</a>`,
+			`<a href="https://example.com/post?id=1" rel="noopener">link</a>`,
+		},
+		{"javascript link", `<a href="javascript:alert(1)">link</a>`, `<a>link</a>`},
+		{"relative image", `<img src="img/1.png" alt="x">`, `<img src="https://example.com/blog/img/1.png" alt="x"/>`},
+		{"tracking pixel", `<p>a<img src="https://t.co/p.gif" width="1" height="1"></p>`, `<p>a</p>`},
+		{"image without src", `<img alt="x" onerror="alert(1)">`, ``},
+		{"comment removed", `a<!-- hidden -->b`, `ab`},
+		{"nested unwrap", `<font><center><i>a</i></center></font><u>b</u>`, `<i>a</i><u>b</u>`},
+	}
+	s := New(DefaultPolicy())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := s.Sanitize(tt.input, "https://example.com/blog/")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	policy := DefaultPolicy()
+	require.NoError(t, policy.Allow([]string{"video", "video.src", "VIDEO.Controls"}))
+	policy.Deny([]string{"img"})
+	s := New(policy)
+
+	result, err := s.Sanitize(`<video src="v.mp4" controls autoplay></video><img src="a.png">`, "https://example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, `<video src="https://example.com/v.mp4" controls=""></video>`, result)
+}
+
+func TestPolicyAllowDropped(t *testing.T) {
+	policy := DefaultPolicy()
+	require.NoError(t, policy.Allow([]string{"iframe", "iframe.src"}))
+	s := New(policy)
+
+	result, err := s.Sanitize(`<iframe src="/embed"></iframe>`, "https://example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, `<iframe src="https://example.com/embed"></iframe>`, result)
+}
+
+func TestPolicyAllowUnsafe(t *testing.T) {
+	for _, entry := range []string{"img.onerror", "a.ONCLICK", "p.style"} {
+		policy := DefaultPolicy()
+		assert.Error(t, policy.Allow([]string{entry}), entry)
+	}
+}
+
+func TestUrlAttrs(t *testing.T) {
+	policy := DefaultPolicy()
+	require.NoError(t, policy.Allow([]string{"video", "video.poster", "img.srcset", "object", "object.data"}))
+	s := New(policy)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`<video poster="javascript:alert(1)"></video>`, `<video></video>`},
+		{`<video poster="/p.png"></video>`, `<video poster="https://example.com/p.png"></video>`},
+		{`<object data="javascript:alert(1)"></object>`, `<object></object>`},
+		{
+			`<img src="a.png" srcset="a.png 1x, /b.png 2x">`,
+			`<img src="https://example.com/a.png" srcset="https://example.com/a.png 1x, https://example.com/b.png 2x"/>`,
+		},
+		{`<img src="a.png" srcset="a.png 1x, javascript:alert(1) 2x">`, `<img src="https://example.com/a.png"/>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := s.Sanitize(tt.input, "https://example.com/")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,406 @@
+package structured
+
+import (
+	"encoding/json"
+	"github.com/labstack/gommon/log"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"slices"
+	"strings"
+)
+
+// Item is a post found in structured data. Values are as in source: urls may be relative, dates are not parsed
+type Item struct {
+	Headline      string
+	Url           string
+	Description   string
+	Author        string
+	Image         string
+	DatePublished string
+	DateModified  string
+}
+
+// articleTypes are schema.org types which become feed items
+var articleTypes = map[string]bool{
+	"Article":                true,
+	"AnalysisNewsArticle":    true,
+	"BackgroundNewsArticle":  true,
+	"BlogPosting":            true,
+	"DiscussionForumPosting": true,
+	"LiveBlogPosting":        true,
+	"NewsArticle":            true,
+	"OpinionNewsArticle":     true,
+	"PodcastEpisode":         true,
+	"Report":                 true,
+	"ReportageNewsArticle":   true,
+	"ReviewNewsArticle":      true,
+	"ScholarlyArticle":       true,
+	"SocialMediaPosting":     true,
+	"TechArticle":            true,
+	"VideoObject":            true,
+}
+
+// Extract finds posts in JSON-LD and microdata of document.
+// If there are none and page itself is an article by OpenGraph, it is returned as single item
+func Extract(doc *html.Node) []Item {
+	items := jsonLdItems(doc)
+	items = append(items, microdataItems(doc)...)
+	if len(items) == 0 {
+		if item, ok := openGraphItem(doc); ok {
+			items = append(items, item)
+		}
+	}
+	return merge(items)
+}
+
+// merge joins items with the same url (or headline, if url is empty), first found values win
+func merge(items []Item) []Item {
+	var result []Item
+	index := make(map[string]int)
+	for _, item := range items {
+		if len(item.Headline) == 0 && len(item.Url) == 0 {
+			continue
+		}
+		key := "url:" + item.Url
+		if len(item.Url) == 0 {
+			key = "headline:" + item.Headline
+		}
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(result)
+			result = append(result, item)
+			continue
+		}
+		existing := &result[i]
+		for _, pair := range [][2]*string{
+			{&existing.Headline, &item.Headline},
+			{&existing.Description, &item.Description},
+			{&existing.Author, &item.Author},
+			{&existing.Image, &item.Image},
+			{&existing.DatePublished, &item.DatePublished},
+			{&existing.DateModified, &item.DateModified},
+		} {
+			if len(*pair[0]) == 0 {
+				*pair[0] = *pair[1]
+			}
+		}
+	}
+	return result
+}
+
+// normalizeType makes "https://schema.org/BlogPosting" and "schema:BlogPosting" just "BlogPosting"
+func normalizeType(t string) string {
+	t = strings.TrimSpace(t)
+	if i := strings.LastIndexAny(t, "/:#"); i >= 0 {
+		t = t[i+1:]
+	}
+	return t
+}
+
+func isArticle(types []string) bool {
+	return slices.ContainsFunc(types, func(t string) bool {
+		return articleTypes[normalizeType(t)]
+	})
+}
+
+func jsonLdItems(doc *html.Node) []Item {
+	var items []Item
+	walk(doc, func(n *html.Node) bool {
+		if n.DataAtom != atom.Script || !strings.EqualFold(strings.TrimSpace(attr(n, "type")), "application/ld+json") {
+			return true
+		}
+		var data any
+		if err := json.Unmarshal([]byte(textContent(n)), &data); err != nil {
+			log.Debugf("json-ld: %v", err)
+			return false
+		}
+		items = append(items, walkJsonLd(data)...)
+		return false
+	})
+	return items
+}
+
+// walkJsonLd looks for articles and list items everywhere: in @graph, itemListElement, mainEntity and so on
+func walkJsonLd(value any) []Item {
+	var items []Item
+	switch v := value.(type) {
+	case []any:
+		for _, child := range v {
+			items = append(items, walkJsonLd(child)...)
+		}
+	case map[string]any:
+		types := jsonLdTypes(v["@type"])
+		if isArticle(types) {
+			return []Item{jsonLdItem(v)}
+		}
+		if slices.Contains(types, "ListItem") {
+			if item, ok := jsonLdListItem(v); ok {
+				return []Item{item}
+			}
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			items = append(items, walkJsonLd(v[key])...)
+		}
+	}
+	return items
+}
+
+func jsonLdTypes(value any) []string {
+	var types []string
+	switch v := value.(type) {
+	case string:
+		types = append(types, normalizeType(v))
+	case []any:
+		for _, t := range v {
+			if s, ok := t.(string); ok {
+				types = append(types, normalizeType(s))
+			}
+		}
+	}
+	return types
+}
+
+// jsonLdListItem handles ItemList elements which are links, not articles
+func jsonLdListItem(v map[string]any) (Item, bool) {
+	switch item := v["item"].(type) {
+	case string:
+		return Item{Headline: jsonString(v["name"]), Url: item}, true
+	case map[string]any:
+		if isArticle(jsonLdTypes(item["@type"])) {
+			return jsonLdItem(item), true
+		}
+		result := jsonLdItem(item)
+		if len(result.Headline) == 0 {
+			result.Headline = jsonString(v["name"])
+		}
+		return result, len(result.Url) > 0
+	}
+	if url := jsonString(v["url"]); len(url) > 0 {
+		return Item{Headline: jsonString(v["name"]), Url: url}, true
+	}
+	return Item{}, false
+}
+
+func jsonLdItem(v map[string]any) Item {
+	item := Item{
+		Headline:      firstNonEmpty(jsonString(v["headline"]), jsonString(v["name"])),
+		Url:           firstNonEmpty(jsonString(v["url"]), jsonLdId(v["mainEntityOfPage"])),
+		Description:   jsonString(v["description"]),
+		Author:        jsonLdNames(v["author"]),
+		Image:         firstNonEmpty(jsonLdUrl(v["image"]), jsonLdUrl(v["thumbnailUrl"])),
+		DatePublished: firstNonEmpty(jsonString(v["datePublished"]), jsonString(v["uploadDate"])),
+		DateModified:  jsonString(v["dateModified"]),
+	}
+	if id := jsonString(v["@id"]); len(item.Url) == 0 && strings.HasPrefix(id, "http") {
+		item.Url = id
+	}
+	return item
+}
+
+// jsonString returns trimmed string, for arrays first element is used
+func jsonString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case []any:
+		if len(v) > 0 {
+			return jsonString(v[0])
+		}
+	}
+	return ""
+}
+
+// jsonLdId is for values which are url or object with @id or url
+func jsonLdId(value any) string {
+	if v, ok := value.(map[string]any); ok {
+		return firstNonEmpty(jsonString(v["@id"]), jsonString(v["url"]))
+	}
+	return jsonString(value)
+}
+
+// jsonLdUrl is for images: url, ImageObject or list of them
+func jsonLdUrl(value any) string {
+	switch v := value.(type) {
+	case map[string]any:
+		return firstNonEmpty(jsonString(v["url"]), jsonString(v["contentUrl"]))
+	case []any:
+		if len(v) > 0 {
+			return jsonLdUrl(v[0])
+		}
+	}
+	return jsonString(value)
+}
+
+// jsonLdNames joins names of Person or Organization objects
+func jsonLdNames(value any) string {
+	switch v := value.(type) {
+	case map[string]any:
+		return jsonString(v["name"])
+	case []any:
+		var names []string
+		for _, author := range v {
+			if name := jsonLdNames(author); len(name) > 0 {
+				names = append(names, name)
+			}
+		}
+		return strings.Join(names, ", ")
+	}
+	return jsonString(value)
+}
+
+func microdataItems(doc *html.Node) []Item {
+	var items []Item
+	walk(doc, func(n *html.Node) bool {
+		if !hasAttr(n, "itemscope") || !isArticle(strings.Fields(attr(n, "itemtype"))) {
+			return true
+		}
+		props := microdataProps(n)
+		item := Item{
+			Headline:      firstNonEmpty(props.value("headline"), props.value("name")),
+			Url:           firstNonEmpty(props.value("url"), props.value("mainEntityOfPage"), attr(n, "itemid")),
+			Description:   props.value("description"),
+			Author:        props.value("author"),
+			Image:         firstNonEmpty(props.value("image"), props.value("thumbnailUrl")),
+			DatePublished: firstNonEmpty(props.value("datePublished"), props.value("uploadDate")),
+			DateModified:  props.value("dateModified"),
+		}
+		items = append(items, item)
+		return false
+	})
+	return items
+}
+
+type microdata map[string][]*html.Node
+
+// microdataProps collects itemprop elements of item, properties of nested items are not included
+func microdataProps(scope *html.Node) microdata {
+	props := make(microdata)
+	var collect func(n *html.Node)
+	collect = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			for _, name := range strings.Fields(attr(c, "itemprop")) {
+				props[name] = append(props[name], c)
+			}
+			if !hasAttr(c, "itemscope") {
+				collect(c)
+			}
+		}
+	}
+	collect(scope)
+	return props
+}
+
+// value of first element with property. Nested item (e.g. Person or ImageObject) gives its name or url
+func (m microdata) value(name string) string {
+	elements := m[name]
+	if len(elements) == 0 {
+		return ""
+	}
+	el := elements[0]
+	if hasAttr(el, "itemscope") {
+		nested := microdataProps(el)
+		return firstNonEmpty(nested.value("name"), nested.value("url"), nested.value("contentUrl"), attr(el, "itemid"))
+	}
+	switch el.DataAtom {
+	case atom.Meta:
+		return strings.TrimSpace(attr(el, "content"))
+	case atom.A, atom.Link, atom.Area:
+		return strings.TrimSpace(attr(el, "href"))
+	case atom.Img, atom.Audio, atom.Video, atom.Source, atom.Iframe, atom.Embed, atom.Track:
+		return strings.TrimSpace(attr(el, "src"))
+	case atom.Time:
+		if hasAttr(el, "datetime") {
+			return strings.TrimSpace(attr(el, "datetime"))
+		}
+	case atom.Data, atom.Meter:
+		return strings.TrimSpace(attr(el, "value"))
+	}
+	return strings.Join(strings.Fields(textContent(el)), " ")
+}
+
+// openGraphItem makes item of article page itself
+func openGraphItem(doc *html.Node) (Item, bool) {
+	meta := make(map[string]string)
+	walk(doc, func(n *html.Node) bool {
+		if n.DataAtom == atom.Meta {
+			property := firstNonEmpty(attr(n, "property"), attr(n, "name"))
+			if _, ok := meta[property]; !ok {
+				meta[property] = strings.TrimSpace(attr(n, "content"))
+			}
+		}
+		return true
+	})
+	if !strings.HasPrefix(meta["og:type"], "article") {
+		return Item{}, false
+	}
+	item := Item{
+		Headline:      meta["og:title"],
+		Url:           meta["og:url"],
+		Description:   meta["og:description"],
+		Author:        meta["article:author"],
+		Image:         meta["og:image"],
+		DatePublished: meta["article:published_time"],
+		DateModified:  meta["article:modified_time"],
+	}
+	return item, len(item.Headline) > 0 || len(item.Url) > 0
+}
+
+// walk calls fn for every element in document order, children are skipped if fn returns false
+func walk(n *html.Node, fn func(n *html.Node) bool) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && !fn(c) {
+			continue
+		}
+		walk(c, fn)
+	}
+}
+
+func attr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func hasAttr(n *html.Node, name string) bool {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == name {
+			return true
+		}
+	}
+	return false
+}
+
+func textContent(n *html.Node) string {
+	var b strings.Builder
+	var collect func(n *html.Node)
+	collect = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.TextNode {
+				b.WriteString(c.Data)
+			}
+			collect(c)
+		}
+	}
+	collect(n)
+	return b.String()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if len(v) > 0 {
+			return v
+		}
+	}
+	return ""
+}
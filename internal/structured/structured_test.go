@@ -0,0 +1,100 @@
+package structured
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+)
+
+const testPage = `<html><head>
+<meta property="og:type" content="website">
+<script type="application/ld+json">
+{
+	"@context": "https://schema.org",
+	"@graph": [
+		{"@type": "WebSite", "name": "Blog", "url": "https://example.com/"},
+		{
+			"@type": "BlogPosting",
+			"headline": "First post",
+			"url": "https://example.com/first",
+			"datePublished": "2025-01-02T10:00:00Z",
+			"author": [{"@type": "Person", "name": "Ann"}, {"@type": "Person", "name": "Bob"}],
+			"image": {"@type": "ImageObject", "url": "https://example.com/first.png"}
+		}
+	]
+}
+</script>
+<script type="application/ld+json">
+{
+	"@type": "ItemList",
+	"itemListElement": [
+		{"@type": "ListItem", "position": 1, "url": "https://example.com/first", "name": "First"},
+		{"@type": "ListItem", "position": 2, "item": "https://example.com/second", "name": "Second post"}
+	]
+}
+</script>
+<script type="application/ld+json">{broken</script>
+</head><body>
+<article itemscope itemtype="https://schema.org/NewsArticle">
+	<h2 itemprop="headline">  Third
+		post </h2>
+	<a itemprop="url" href="/third">read</a>
+	<time itemprop="datePublished" datetime="2025-01-04">Jan 4</time>
+	<meta itemprop="dateModified" content="2025-01-05">
+	<span itemprop="author" itemscope itemtype="https://schema.org/Person">
+		<span itemprop="name">Carl</span>
+		<a itemprop="url" href="/carl">profile</a>
+	</span>
+	<img itemprop="image" src="/third.png">
+	<p itemprop="description">Third description</p>
+</article>
+</body></html>`
+
+func parse(t *testing.T, page string) *html.Node {
+	doc, err := html.Parse(strings.NewReader(page))
+	require.NoError(t, err)
+	return doc
+}
+
+func TestExtract(t *testing.T) {
+	items := Extract(parse(t, testPage))
+	assert.Equal(t, []Item{
+		{
+			Headline:      "First post",
+			Url:           "https://example.com/first",
+			Author:        "Ann, Bob",
+			Image:         "https://example.com/first.png",
+			DatePublished: "2025-01-02T10:00:00Z",
+		},
+		{Headline: "Second post", Url: "https://example.com/second"},
+		{
+			Headline:      "Third post",
+			Url:           "/third",
+			Description:   "Third description",
+			Author:        "Carl",
+			Image:         "/third.png",
+			DatePublished: "2025-01-04",
+			DateModified:  "2025-01-05",
+		},
+	}, items)
+}
+
+func TestOpenGraph(t *testing.T) {
+	page := `<html><head>
+		<meta property="og:type" content="article">
+		<meta property="og:title" content="Article">
+		<meta property="og:url" content="https://example.com/article">
+		<meta property="article:published_time" content="2025-01-02">
+	</head><body></body></html>`
+	assert.Equal(t, []Item{{
+		Headline:      "Article",
+		Url:           "https://example.com/article",
+		DatePublished: "2025-01-02",
+	}}, Extract(parse(t, page)))
+
+	website := strings.Replace(page, `content="article"`, `content="website"`, 1)
+	assert.Empty(t, Extract(parse(t, website)))
+}
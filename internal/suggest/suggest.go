@@ -0,0 +1,390 @@
+package suggest
+
+import (
+	"cmp"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	minPosts       = 3
+	minLinkShare   = 0.8 // posts without links are not posts
+	minTextLength  = 15  // menus, pagers and tag lists have shorter items
+	minFieldShare  = 0.5 // optional field selector must be found in this share of posts
+	descriptionLen = 20
+)
+
+// containers which have repeated links, but never posts
+var skipTags = map[atom.Atom]bool{
+	atom.Head:     true,
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Nav:      true,
+	atom.Header:   true,
+	atom.Footer:   true,
+	atom.Form:     true,
+	atom.Select:   true,
+}
+
+// Only plain identifiers without digits are used: generated classes like css-1x2y3 or post-123 are not stable
+var stableIdent = regexp.MustCompile(`^-?[A-Za-z_][A-Za-z_-]*$`)
+
+// Suggest finds repeated sibling structures with links, headings, dates and images,
+// and returns selectors for them, best first. Selectors are css, field selectors are relative to post.
+// Preview is not filled
+func Suggest(doc *html.Node, limit int) []models.SelectorSuggestion {
+	var suggestions []models.SelectorSuggestion
+	for _, g := range findGroups(doc) {
+		score, ok := g.score()
+		if !ok {
+			continue
+		}
+		s := g.selectors(doc)
+		s.Score = score
+		if i := slices.IndexFunc(suggestions, func(other models.SelectorSuggestion) bool {
+			return other.SelectorPost == s.SelectorPost
+		}); i >= 0 {
+			suggestions[i].Score = max(suggestions[i].Score, score)
+			continue
+		}
+		suggestions = append(suggestions, s)
+	}
+	slices.SortStableFunc(suggestions, func(a, b models.SelectorSuggestion) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// group is siblings with the same tag and stable classes
+type group struct {
+	parent *html.Node
+	sel    simpleSelector
+	posts  []*html.Node
+}
+
+func findGroups(doc *html.Node) []group {
+	var groups []group
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		buckets := make(map[string]*group)
+		var order []string
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode || skipTags[c.DataAtom] {
+				continue
+			}
+			walk(c)
+			sel := selectorOf(c)
+			key := sel.String()
+			if _, ok := buckets[key]; !ok {
+				buckets[key] = &group{parent: n, sel: sel}
+				order = append(order, key)
+			}
+			buckets[key].posts = append(buckets[key].posts, c)
+		}
+		for _, key := range order {
+			if g := buckets[key]; len(g.posts) >= minPosts {
+				groups = append(groups, *g)
+			}
+		}
+	}
+	walk(doc)
+	return groups
+}
+
+// score is higher for larger groups which have more of post features. Groups which don't look like posts are rejected
+func (g group) score() (float64, bool) {
+	var links, headings, times, images, text float64
+	for _, post := range g.posts {
+		if findLink(post) != nil {
+			links++
+		}
+		if findFirst(post, isHeading) != nil {
+			headings++
+		}
+		if findFirst(post, isTime) != nil {
+			times++
+		}
+		if findFirst(post, isImage) != nil {
+			images++
+		}
+		text += float64(len(collapsedText(post)))
+	}
+	n := float64(len(g.posts))
+	avgText := text / n
+	if links/n < minLinkShare || avgText < minTextLength {
+		return 0, false
+	}
+	features := links/n + headings/n + times/n + 0.5*images/n + 0.5*min(avgText/200, 1)
+	return math.Round(features*math.Log2(n+1)*100) / 100, true
+}
+
+func (g group) selectors(doc *html.Node) models.SelectorSuggestion {
+	s := models.SelectorSuggestion{SelectorPost: g.postSelector(doc)}
+
+	title := relativeSelector(g.posts, func(post *html.Node) *html.Node {
+		if heading := findFirst(post, isHeading); heading != nil {
+			return heading
+		}
+		return findLink(post)
+	})
+	s.SelectorTitle = title
+	s.SelectorLink = relativeSelector(g.posts, findLink)
+	s.SelectorDescription = relativeSelector(g.posts, func(post *html.Node) *html.Node {
+		return findFirst(post, func(n *html.Node) bool {
+			return n.DataAtom == atom.P && len(collapsedText(n)) >= descriptionLen && findAncestor(n, post, isHeading) == nil
+		})
+	})
+	s.SelectorCreated = relativeSelector(g.posts, func(post *html.Node) *html.Node {
+		return findFirst(post, isTime)
+	})
+	if len(s.SelectorCreated) > 0 {
+		if t := findFirst(g.posts[0], isTime); t != nil && hasAttr(t, "datetime") {
+			s.CreatedAttributeName = "datetime"
+		}
+	}
+	s.SelectorEnclosure = relativeSelector(g.posts, func(post *html.Node) *html.Node {
+		return findFirst(post, isImage)
+	})
+	return s
+}
+
+// postSelector is selector of group elements, parent is added if elements alone match too much
+func (g group) postSelector(doc *html.Node) string {
+	if countMatches(doc, g.sel) == len(g.posts) || g.parent.Type != html.ElementNode {
+		return g.sel.String()
+	}
+	parent := selectorOf(g.parent)
+	if id := getAttr(g.parent, "id"); stableIdent.MatchString(id) {
+		parent = simpleSelector{id: id}
+	}
+	return parent.String() + " > " + g.sel.String()
+}
+
+// relativeSelector makes selector which finds target element in most posts.
+// Candidates are built from target of first post: tag with classes, tag alone and, for links in headings, heading and link
+func relativeSelector(posts []*html.Node, find func(post *html.Node) *html.Node) string {
+	targets := make([]*html.Node, len(posts))
+	var sample, samplePost *html.Node
+	found := 0
+	for i, post := range posts {
+		targets[i] = find(post)
+		if targets[i] != nil {
+			found++
+			if sample == nil {
+				sample, samplePost = targets[i], post
+			}
+		}
+	}
+	if sample == nil || float64(found)/float64(len(posts)) < minFieldShare {
+		return ""
+	}
+
+	full := selectorOf(sample)
+	candidates := [][]simpleSelector{{full}, {{tag: full.tag}}}
+	if heading := findAncestor(sample, samplePost, isHeading); heading != nil {
+		candidates = append(candidates, []simpleSelector{{tag: heading.Data}, {tag: full.tag}})
+	}
+
+	best, bestHits := "", 0
+	for _, chain := range candidates {
+		hits := 0
+		for i, post := range posts {
+			if targets[i] != nil && matchFirst(post, chain) == targets[i] {
+				hits++
+			}
+		}
+		// later candidates win ties: tag alone is more robust than classes, heading link than first link
+		if hits > 0 && hits >= bestHits {
+			best, bestHits = chainString(chain), hits
+		}
+	}
+	if float64(bestHits)/float64(len(posts)) < minFieldShare {
+		return ""
+	}
+	return best
+}
+
+// simpleSelector is tag with classes, or id
+type simpleSelector struct {
+	tag     string
+	id      string
+	classes []string
+}
+
+func selectorOf(n *html.Node) simpleSelector {
+	sel := simpleSelector{tag: n.Data}
+	for _, class := range strings.Fields(getAttr(n, "class")) {
+		if stableIdent.MatchString(class) && !slices.Contains(sel.classes, class) {
+			sel.classes = append(sel.classes, class)
+		}
+	}
+	slices.Sort(sel.classes)
+	return sel
+}
+
+func (s simpleSelector) String() string {
+	if len(s.id) > 0 {
+		return "#" + s.id
+	}
+	return s.tag + strings.Join(append([]string{""}, s.classes...), ".")
+}
+
+func (s simpleSelector) matches(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	if len(s.id) > 0 {
+		return getAttr(n, "id") == s.id
+	}
+	if n.Data != s.tag {
+		return false
+	}
+	classes := strings.Fields(getAttr(n, "class"))
+	for _, class := range s.classes {
+		if !slices.Contains(classes, class) {
+			return false
+		}
+	}
+	return true
+}
+
+func chainString(chain []simpleSelector) string {
+	parts := make([]string, len(chain))
+	for i, sel := range chain {
+		parts[i] = sel.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+// matchFirst finds first descendant of root matching chain of descendant selectors
+func matchFirst(root *html.Node, chain []simpleSelector) *html.Node {
+	return findFirst(root, func(n *html.Node) bool {
+		if !chain[len(chain)-1].matches(n) {
+			return false
+		}
+		i := len(chain) - 2
+		for p := n.Parent; p != nil && p != root && i >= 0; p = p.Parent {
+			if chain[i].matches(p) {
+				i--
+			}
+		}
+		return i < 0
+	})
+}
+
+func countMatches(root *html.Node, sel simpleSelector) int {
+	count := 0
+	for c := root.FirstChild; c != nil; c = c.NextSibling {
+		if sel.matches(c) {
+			count++
+		}
+		count += countMatches(c, sel)
+	}
+	return count
+}
+
+// findFirst finds descendant in document order, root is not included
+func findFirst(root *html.Node, fn func(n *html.Node) bool) *html.Node {
+	for c := root.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && fn(c) {
+			return c
+		}
+		if found := findFirst(c, fn); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// findAncestor finds ancestor of n below root, n itself is included
+func findAncestor(n, root *html.Node, fn func(n *html.Node) bool) *html.Node {
+	for ; n != nil && n != root; n = n.Parent {
+		if fn(n) {
+			return n
+		}
+	}
+	return nil
+}
+
+// findLink prefers link of heading, which is usually the post link
+func findLink(post *html.Node) *html.Node {
+	if heading := findFirst(post, isHeading); heading != nil {
+		if link := findAncestor(heading, post, isLink); link != nil {
+			return link
+		}
+		if link := findFirst(heading, isLink); link != nil {
+			return link
+		}
+	}
+	return findFirst(post, func(n *html.Node) bool {
+		return isLink(n) && len(collapsedText(n)) > 0
+	})
+}
+
+func isLink(n *html.Node) bool {
+	href := strings.TrimSpace(getAttr(n, "href"))
+	return n.DataAtom == atom.A && len(href) > 0 && !strings.HasPrefix(href, "#") &&
+		!strings.HasPrefix(strings.ToLower(href), "javascript:")
+}
+
+func isHeading(n *html.Node) bool {
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		return true
+	}
+	return false
+}
+
+func isTime(n *html.Node) bool {
+	return n.DataAtom == atom.Time
+}
+
+func isImage(n *html.Node) bool {
+	return n.DataAtom == atom.Img && len(strings.TrimSpace(getAttr(n, "src"))) > 0
+}
+
+func getAttr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func hasAttr(n *html.Node, name string) bool {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == name {
+			return true
+		}
+	}
+	return false
+}
+
+func collapsedText(n *html.Node) string {
+	var b strings.Builder
+	var collect func(n *html.Node)
+	collect = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			switch {
+			case c.Type == html.TextNode:
+				b.WriteString(c.Data)
+				b.WriteByte(' ')
+			case c.Type == html.ElementNode && c.DataAtom != atom.Script && c.DataAtom != atom.Style:
+				collect(c)
+			}
+		}
+	}
+	collect(n)
+	return strings.Join(strings.Fields(b.String()), " ")
+}
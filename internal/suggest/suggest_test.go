@@ -0,0 +1,60 @@
+package suggest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+)
+
+func testPage() string {
+	var b strings.Builder
+	b.WriteString(`<html><body>
+		<nav><ul><li><a href="/">Home</a></li><li><a href="/about">About</a></li><li><a href="/blog">Blog</a></li></ul></nav>
+		<ul class="tags"><li><a href="/t/1">go</a></li><li><a href="/t/2">rss</a></li><li><a href="/t/3">web</a></li></ul>
+		<div id="posts">`)
+	for i := 1; i <= 5; i++ {
+		fmt.Fprintf(&b, `<div class="card card-%d">
+			<img src="/covers/%d.jpg">
+			<h3 class="card-title"><a class="link" href="/posts/%d">Post number %d</a></h3>
+			<span class="card-meta"><a href="/authors/%d">Author</a> <time datetime="2025-01-0%dT10:00:00Z">Jan %d</time></span>
+			<p>Summary of post %d which is long enough</p>
+		</div>`, i, i, i, i, i, i, i, i)
+	}
+	b.WriteString(`</div>
+		<div class="sidebar"><div class="card"><a href="/ad">Ad</a></div></div>
+		<div class="pager"><a href="?page=1">1</a><a href="?page=2">2</a><a href="?page=3">3</a></div>
+	</body></html>`)
+	return b.String()
+}
+
+func TestSuggest(t *testing.T) {
+	doc, err := html.Parse(strings.NewReader(testPage()))
+	require.NoError(t, err)
+
+	suggestions := Suggest(doc, 5)
+	require.NotEmpty(t, suggestions)
+	best := suggestions[0]
+	assert.Equal(t, "#posts > div.card", best.SelectorPost, "sidebar card is not matched")
+	assert.Equal(t, "h3", best.SelectorTitle)
+	assert.Equal(t, "h3 a", best.SelectorLink)
+	assert.Equal(t, "p", best.SelectorDescription)
+	assert.Equal(t, "time", best.SelectorCreated)
+	assert.Equal(t, "datetime", best.CreatedAttributeName)
+	assert.Equal(t, "img", best.SelectorEnclosure)
+
+	for _, s := range suggestions {
+		assert.NotContains(t, []string{"ul.tags > li", "li", "a"}, s.SelectorPost, "short items are not posts")
+		assert.LessOrEqual(t, s.Score, best.Score)
+	}
+	assert.Len(t, Suggest(doc, 1), 1)
+}
+
+func TestSuggestNothing(t *testing.T) {
+	doc, err := html.Parse(strings.NewReader(`<html><body><p>Just text</p><p>And more</p><p>No links</p></body></html>`))
+	require.NoError(t, err)
+	assert.Empty(t, Suggest(doc, 5))
+}
@@ -0,0 +1,266 @@
+package transform
+
+import (
+	"errors"
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/labstack/gommon/log"
+	"regexp"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+// Template output is limited to prevent abuse
+const maxTemplateOutput = 64 * 1024
+
+var errOutputTooLong = errors.New("template output too long")
+
+// Functions which can be called in templates. Their cost is bounded by arguments size.
+// printf is not here: its width flag makes huge strings before output limit is checked
+var allowedFuncs = map[string]bool{
+	"and": true, "or": true, "not": true,
+	"eq": true, "ne": true, "lt": true, "le": true, "gt": true, "ge": true,
+	"len": true, "index": true, "slice": true,
+	"print": true, "println": true, "html": true, "js": true, "urlquery": true,
+}
+
+// TemplateData is available in Template transforms, e.g. "{{.Author}}: {{.Title}}"
+type TemplateData struct {
+	Title       string
+	Link        string
+	Description string
+	Author      string
+	Content     string
+	Created     time.Time
+	Updated     time.Time
+	Enclosure   string
+}
+
+type step struct {
+	transform models.Transform
+	re        *regexp.Regexp
+	tmpl      *template.Template
+}
+
+// Pipeline applies transforms to extracted fields.
+// Transforms of a field before its first Template are applied as soon as field is read (see Apply),
+// Template and transforms after it are applied when all fields are read (see ApplyTemplates).
+// Nil Pipeline does nothing
+type Pipeline struct {
+	immediate map[string][]step
+	deferred  map[string][]step
+	order     []string // fields with deferred steps, in order of first appearance
+}
+
+func Compile(transforms []models.Transform) (*Pipeline, error) {
+	p := Pipeline{
+		immediate: make(map[string][]step),
+		deferred:  make(map[string][]step),
+	}
+	for i, transform := range transforms {
+		s := step{transform: transform}
+		var err error
+		switch transform.Type {
+		case models.TransformType_RegexExtract, models.TransformType_RegexReplace:
+			s.re, err = regexp.Compile(transform.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("transform %d: compile regex: %w", i+1, err)
+			}
+		case models.TransformType_Template:
+			if transform.Field == "created" || transform.Field == "updated" {
+				return nil, fmt.Errorf("transform %d: template can't be used for %s date", i+1, transform.Field)
+			}
+			s.tmpl, err = template.New(transform.Field).Option("missingkey=error").Parse(transform.Value)
+			if err != nil {
+				return nil, fmt.Errorf("transform %d: parse template: %w", i+1, err)
+			}
+			if err := checkTemplate(s.tmpl); err != nil {
+				return nil, fmt.Errorf("transform %d: template: %w", i+1, err)
+			}
+		case models.TransformType_Trim, models.TransformType_Lowercase, models.TransformType_Uppercase,
+			models.TransformType_Prefix, models.TransformType_Suffix:
+			// nothing to compile
+		default:
+			return nil, fmt.Errorf("transform %d: invalid type", i+1)
+		}
+
+		_, isDeferred := p.deferred[transform.Field]
+		if isDeferred || transform.Type == models.TransformType_Template {
+			if !isDeferred {
+				p.order = append(p.order, transform.Field)
+			}
+			p.deferred[transform.Field] = append(p.deferred[transform.Field], s)
+		} else {
+			p.immediate[transform.Field] = append(p.immediate[transform.Field], s)
+		}
+	}
+	return &p, nil
+}
+
+// Apply transforms of the field which don't depend on other fields
+func (p *Pipeline) Apply(field string, value string) string {
+	if p == nil {
+		return value
+	}
+	for _, s := range p.immediate[field] {
+		value = s.apply(value, nil)
+	}
+	return value
+}
+
+// ApplyTemplates runs templates and remaining transforms. All templates see field values as they were before this call
+func (p *Pipeline) ApplyTemplates(item *models.FeedItem) {
+	if p == nil || len(p.order) == 0 {
+		return
+	}
+	data := TemplateData{
+		Title:       item.Title,
+		Link:        item.Link,
+		Description: item.Description,
+		Author:      item.AuthorName,
+		Content:     item.Content,
+		Created:     item.Created,
+		Updated:     item.Updated,
+		Enclosure:   item.Enclosure,
+	}
+	for _, field := range p.order {
+		target := itemField(item, field)
+		if target == nil {
+			continue
+		}
+		value := *target
+		for _, s := range p.deferred[field] {
+			value = s.apply(value, &data)
+		}
+		*target = value
+	}
+}
+
+func (s step) apply(value string, data *TemplateData) string {
+	t := s.transform
+	switch t.Type {
+	case models.TransformType_RegexExtract:
+		match := s.re.FindStringSubmatch(value)
+		switch {
+		case match == nil:
+			return ""
+		case len(match) > 1:
+			return match[1]
+		default:
+			return match[0]
+		}
+	case models.TransformType_RegexReplace:
+		return s.re.ReplaceAllString(value, t.Value)
+	case models.TransformType_Trim:
+		if len(t.Value) > 0 {
+			return strings.Trim(value, t.Value)
+		}
+		return strings.TrimSpace(value)
+	case models.TransformType_Lowercase:
+		return strings.ToLower(value)
+	case models.TransformType_Uppercase:
+		return strings.ToUpper(value)
+	case models.TransformType_Prefix:
+		return t.Value + value
+	case models.TransformType_Suffix:
+		return value + t.Value
+	case models.TransformType_Template:
+		if data == nil {
+			return value
+		}
+		out := limitedWriter{limit: maxTemplateOutput}
+		if err := s.tmpl.Execute(&out, data); err != nil {
+			log.Warnf("transform template for %s: %v", t.Field, err)
+			return value
+		}
+		return out.String()
+	default:
+		return value
+	}
+}
+
+// checkTemplate allows only text and actions with simple pipelines.
+// Templates come from url and run on worker, so control structures, which can loop for unbounded time, are rejected
+func checkTemplate(tmpl *template.Template) error {
+	if len(tmpl.Templates()) > 1 {
+		return errors.New("define and block are not allowed")
+	}
+	if tmpl.Tree == nil {
+		return nil
+	}
+	return checkNode(tmpl.Tree.Root)
+}
+
+func checkNode(node parse.Node) error {
+	var children []parse.Node
+	switch n := node.(type) {
+	case *parse.ListNode:
+		children = n.Nodes
+	case *parse.ActionNode:
+		children = []parse.Node{n.Pipe}
+	case *parse.PipeNode:
+		for _, cmd := range n.Cmds {
+			children = append(children, cmd)
+		}
+	case *parse.CommandNode:
+		children = n.Args
+	case *parse.ChainNode:
+		children = []parse.Node{n.Node}
+	case *parse.IdentifierNode:
+		if !allowedFuncs[n.Ident] {
+			return fmt.Errorf("function %s is not allowed", n.Ident)
+		}
+	case *parse.TextNode, *parse.FieldNode, *parse.VariableNode, *parse.DotNode,
+		*parse.StringNode, *parse.NumberNode, *parse.BoolNode, *parse.NilNode:
+		// leaf values
+	case *parse.IfNode:
+		return errors.New("if is not allowed")
+	case *parse.RangeNode:
+		return errors.New("range is not allowed")
+	case *parse.WithNode:
+		return errors.New("with is not allowed")
+	case *parse.TemplateNode:
+		return errors.New("template and block are not allowed")
+	default:
+		return fmt.Errorf("action %s is not allowed", node)
+	}
+	for _, child := range children {
+		if err := checkNode(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func itemField(item *models.FeedItem, field string) *string {
+	switch field {
+	case "title":
+		return &item.Title
+	case "link":
+		return &item.Link
+	case "description":
+		return &item.Description
+	case "author":
+		return &item.AuthorName
+	case "content":
+		return &item.Content
+	case "enclosure":
+		return &item.Enclosure
+	default:
+		return nil
+	}
+}
+
+type limitedWriter struct {
+	strings.Builder
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.Len()+len(p) > w.limit {
+		return 0, errOutputTooLong
+	}
+	return w.Builder.Write(p)
+}
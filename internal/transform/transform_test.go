@@ -0,0 +1,135 @@
+package transform
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name       string
+		transforms []models.Transform
+		input      string
+		expected   string
+	}{
+		{"no transforms", nil, " By John ", " By John "},
+		{
+			"regex extract group",
+			[]models.Transform{{Field: "author", Type: models.TransformType_RegexExtract, Pattern: `By (\w+ \w+)`}},
+			"By John Smith • 5 min read",
+			"John Smith",
+		},
+		{
+			"regex extract no match",
+			[]models.Transform{{Field: "author", Type: models.TransformType_RegexExtract, Pattern: `\d+`}},
+			"John",
+			"",
+		},
+		{
+			"regex replace",
+			[]models.Transform{{Field: "author", Type: models.TransformType_RegexReplace, Pattern: `\s*•.*$`, Value: ""}},
+			"John • 5 min read",
+			"John",
+		},
+		{
+			"trim and case",
+			[]models.Transform{
+				{Field: "author", Type: models.TransformType_Trim},
+				{Field: "author", Type: models.TransformType_Uppercase},
+				{Field: "author", Type: models.TransformType_Trim, Value: "@"},
+			},
+			"  @john  ",
+			"JOHN",
+		},
+		{
+			"prefix and suffix",
+			[]models.Transform{
+				{Field: "author", Type: models.TransformType_Prefix, Value: "by "},
+				{Field: "author", Type: models.TransformType_Suffix, Value: "!"},
+				{Field: "title", Type: models.TransformType_Suffix, Value: "?"},
+			},
+			"john",
+			"by john!",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Compile(tt.transforms)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, p.Apply("author", tt.input))
+		})
+	}
+}
+
+func TestApplyTemplates(t *testing.T) {
+	p, err := Compile([]models.Transform{
+		{Field: "title", Type: models.TransformType_Lowercase},
+		{Field: "title", Type: models.TransformType_Template, Value: "{{.Author}}: {{.Title}}"},
+		{Field: "title", Type: models.TransformType_Suffix, Value: "."},
+		{Field: "author", Type: models.TransformType_Template, Value: "{{.Author}} ({{.Title}})"},
+	})
+	require.NoError(t, err)
+
+	item := models.FeedItem{AuthorName: "John"}
+	item.Title = p.Apply("title", "HELLO")
+	assert.Equal(t, "hello", item.Title)
+	p.ApplyTemplates(&item)
+	assert.Equal(t, "John: hello.", item.Title)
+	assert.Equal(t, "John (hello)", item.AuthorName, "templates see values before templates")
+
+	var nilPipeline *Pipeline
+	assert.Equal(t, "a", nilPipeline.Apply("title", "a"))
+	nilPipeline.ApplyTemplates(&item)
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		transform models.Transform
+	}{
+		{"bad regex", models.Transform{Field: "title", Type: models.TransformType_RegexReplace, Pattern: "("}},
+		{"bad template", models.Transform{Field: "title", Type: models.TransformType_Template, Value: "{{.Title"}},
+		{"template for created", models.Transform{Field: "created", Type: models.TransformType_Template, Value: "x"}},
+		{"template range", models.Transform{Field: "title", Type: models.TransformType_Template, Value: "{{range 100000000}}{{end}}x"}},
+		{"template nested if", models.Transform{Field: "title", Type: models.TransformType_Template, Value: "({{with .Title}}{{if .}}a{{end}}{{end}})"}},
+		{"template define", models.Transform{Field: "title", Type: models.TransformType_Template, Value: `{{define "a"}}x{{end}}{{template "a"}}`}},
+		{"template block", models.Transform{Field: "title", Type: models.TransformType_Template, Value: `{{block "a" .}}x{{end}}`}},
+		{"template printf", models.Transform{Field: "title", Type: models.TransformType_Template, Value: `{{printf "%0999999999d" 1}}`}},
+		{"bad type", models.Transform{Field: "title", Type: 100}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile([]models.Transform{tt.transform})
+			assert.ErrorContains(t, err, "transform 1")
+		})
+	}
+}
+
+func TestTemplateOutputLimit(t *testing.T) {
+	p, err := Compile([]models.Transform{
+		{Field: "title", Type: models.TransformType_Template, Value: "{{.Title}}{{.Title}}"},
+	})
+	require.NoError(t, err)
+	item := models.FeedItem{Title: strings.Repeat("long title", 4000)}
+	p.ApplyTemplates(&item)
+	assert.Equal(t, strings.Repeat("long title", 4000), item.Title)
+}
+
+func TestTemplatePipelines(t *testing.T) {
+	p, err := Compile([]models.Transform{
+		{
+			Field: "title",
+			Type:  models.TransformType_Template,
+			Value: `{{$a := .Author}}{{$a | html}}: {{.Title}} ({{.Created.Format "2006"}}, {{len .Link}})`,
+		},
+	})
+	require.NoError(t, err)
+	item := models.FeedItem{Title: "Hello", AuthorName: "<John>", Link: "/a", Created: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}
+	p.ApplyTemplates(&item)
+	assert.Equal(t, "&lt;John&gt;: Hello (2024, 2)", item.Title)
+}
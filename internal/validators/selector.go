@@ -0,0 +1,250 @@
+package validators
+
+import (
+	"fmt"
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/ericchiang/css"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CheckSelector returns error if selector is invalid in given dialect.
+// Css is checked by parser, xpath and playwright-specific parts only for syntax sanity,
+// rest of errors are reported by playwright during extraction
+func CheckSelector(selector string, dialect models.SelectorDialect) error {
+	switch dialect {
+	case models.SelectorDialect_Css:
+		_, err := css.Parse(selector)
+		return err
+	case models.SelectorDialect_XPath:
+		return checkXPath(selector)
+	case models.SelectorDialect_Playwright:
+		return checkPlaywright(selector)
+	default:
+		return fmt.Errorf("unknown selector dialect %d", dialect)
+	}
+}
+
+// checkXPath checks that quotes and brackets are balanced and expression is not cut off
+func checkXPath(selector string) error {
+	selector = strings.TrimSpace(selector)
+	if len(selector) == 0 {
+		return fmt.Errorf("empty xpath")
+	}
+	pairs := map[rune]rune{')': '(', ']': '['}
+	var stack []rune
+	var quote rune
+	for i, r := range selector {
+		if quote != 0 {
+			if r == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch r {
+		case '\'', '"':
+			quote = r
+		case '(', '[':
+			stack = append(stack, r)
+		case ')', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[r] {
+				return fmt.Errorf("unexpected %q at position %d", r, i)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if quote != 0 {
+		return fmt.Errorf("unclosed quote %q", quote)
+	}
+	if len(stack) > 0 {
+		return fmt.Errorf("unclosed %q", stack[len(stack)-1])
+	}
+	if selector != "/" {
+		for _, suffix := range []string{"/", "::", "|", "@", ","} {
+			if strings.HasSuffix(selector, suffix) {
+				return fmt.Errorf("unexpected end after %q", suffix)
+			}
+		}
+	}
+	return nil
+}
+
+var playwrightEngineRe = regexp.MustCompile(`^([a-zA-Z0-9_+:*-]+)=`)
+
+// checkPlaywright checks each part of chain like `article >> text="Read more"`
+func checkPlaywright(selector string) error {
+	parts, err := splitOutsideQuotes(selector, ">>")
+	if err != nil {
+		return err
+	}
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			return fmt.Errorf("part %d is empty", i+1)
+		}
+		if err := checkPlaywrightPart(part); err != nil {
+			return fmt.Errorf("part %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func checkPlaywrightPart(part string) error {
+	engine, body := "", part
+	if m := playwrightEngineRe.FindStringSubmatch(part); m != nil {
+		engine, body = m[1], strings.TrimSpace(part[len(m[0]):])
+	} else if strings.HasPrefix(part, "//") || strings.HasPrefix(part, "..") {
+		engine = "xpath"
+	} else if strings.HasPrefix(part, `"`) || strings.HasPrefix(part, "'") {
+		engine = "text"
+	} else {
+		engine = "css"
+	}
+
+	switch engine {
+	case "css":
+		return checkPlaywrightCss(body)
+	case "xpath":
+		return checkXPath(body)
+	case "text", "id", "data-testid", "data-test-id", "data-test":
+		if len(body) == 0 {
+			return fmt.Errorf("empty %s selector", engine)
+		}
+		if quote := body[0]; quote == '"' || quote == '\'' {
+			if len(body) < 2 || !strings.ContainsRune(body[1:], rune(quote)) {
+				return fmt.Errorf("unclosed quote in %s selector", engine)
+			}
+		}
+		return nil
+	case "nth":
+		if _, err := strconv.Atoi(body); err != nil {
+			return fmt.Errorf("nth must be integer")
+		}
+		return nil
+	case "visible":
+		if body != "true" && body != "false" {
+			return fmt.Errorf("visible must be true or false")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown selector engine %q", engine)
+	}
+}
+
+// playwrightPseudoClasses are not understood by css parser, so they are removed before parsing
+var playwrightPseudoClasses = []string{
+	"has-text", "text-is", "text-matches", "text", "visible", "nth-match",
+	"left-of", "right-of", "above", "below", "near", "is", "not", "where", "scope",
+}
+
+// checkPlaywrightCss checks css with playwright extensions like `article:has-text("News")`
+func checkPlaywrightCss(selector string) error {
+	var stripped strings.Builder
+	var quote byte
+	for i := 0; i < len(selector); i++ {
+		c := selector[i]
+		if quote != 0 {
+			if c == quote {
+				quote = 0
+			}
+			stripped.WriteByte(c)
+			continue
+		}
+		if c == '"' || c == '\'' {
+			quote = c
+		}
+		if c == ':' {
+			if end, ok := skipPseudoClass(selector, i+1); ok {
+				i = end - 1
+				continue
+			}
+		}
+		stripped.WriteByte(c)
+	}
+	if quote != 0 {
+		return fmt.Errorf("unclosed quote %q", quote)
+	}
+
+	cssSelector := strings.TrimSpace(stripped.String())
+	if len(cssSelector) == 0 || strings.ContainsAny(cssSelector[len(cssSelector)-1:], ">+~,") {
+		cssSelector += "*" // pseudo-class was the whole compound selector
+	}
+	_, err := css.Parse(cssSelector)
+	return err
+}
+
+// skipPseudoClass returns position after playwright pseudo-class name and its arguments, which start at pos
+func skipPseudoClass(selector string, pos int) (int, bool) {
+	for _, name := range playwrightPseudoClasses {
+		if !strings.HasPrefix(selector[pos:], name) {
+			continue
+		}
+		end := pos + len(name)
+		if end < len(selector) && (isNameChar(selector[end])) {
+			continue // e.g. text-is while checking text
+		}
+		if end == len(selector) || selector[end] != '(' {
+			return end, true
+		}
+		depth := 0
+		var quote byte
+		for ; end < len(selector); end++ {
+			c := selector[end]
+			if quote != 0 {
+				if c == quote {
+					quote = 0
+				}
+				continue
+			}
+			switch c {
+			case '"', '\'':
+				quote = c
+			case '(':
+				depth++
+			case ')':
+				depth--
+				if depth == 0 {
+					return end + 1, true
+				}
+			}
+		}
+		return len(selector), false
+	}
+	return 0, false
+}
+
+func isNameChar(c byte) bool {
+	return c == '-' || c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// splitOutsideQuotes splits s by sep, ignoring separators in quoted strings
+func splitOutsideQuotes(s string, sep string) ([]string, error) {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if c == '"' || c == '\'' {
+			quote = c
+			continue
+		}
+		if strings.HasPrefix(s[i:], sep) {
+			parts = append(parts, s[start:i])
+			i += len(sep) - 1
+			start = i + 1
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unclosed quote %q", quote)
+	}
+	return append(parts, s[start:]), nil
+}
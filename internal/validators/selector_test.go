@@ -0,0 +1,82 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/egor3f/rssalchemy/internal/models"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		dialect  models.SelectorDialect
+		valid    bool
+	}{
+		{"css", "article > h2 a[href]", models.SelectorDialect_Css, true},
+		{"css invalid", "article >", models.SelectorDialect_Css, false},
+		{"css rejects playwright", "article:has-text('News')", models.SelectorDialect_Css, false},
+
+		{"xpath", "//div[@class='post']/following-sibling::p[1]", models.SelectorDialect_XPath, true},
+		{"xpath relative", "following-sibling::div[contains(text(), ']')]", models.SelectorDialect_XPath, true},
+		{"xpath root", "/", models.SelectorDialect_XPath, true},
+		{"xpath unclosed bracket", "//div[@id='a'", models.SelectorDialect_XPath, false},
+		{"xpath unclosed quote", "//div[@id='a]", models.SelectorDialect_XPath, false},
+		{"xpath mismatched", "//div(]", models.SelectorDialect_XPath, false},
+		{"xpath cut off", "//div/", models.SelectorDialect_XPath, false},
+		{"xpath axis cut off", "following-sibling::", models.SelectorDialect_XPath, false},
+		{"xpath empty", " ", models.SelectorDialect_XPath, false},
+
+		{"playwright css", "article h2", models.SelectorDialect_Playwright, true},
+		{"playwright has-text", `article:has-text("Read more")`, models.SelectorDialect_Playwright, true},
+		{"playwright pseudo only", `:text-is("News") >> ..`, models.SelectorDialect_Playwright, true},
+		{"playwright not", "li:not(.ad) > a", models.SelectorDialect_Playwright, true},
+		{"playwright chain", `article >> text="Read more" >> nth=0`, models.SelectorDialect_Playwright, true},
+		{"playwright chain in quotes", `text="a >> b"`, models.SelectorDialect_Playwright, true},
+		{"playwright xpath", "xpath=//div[@id='a']", models.SelectorDialect_Playwright, true},
+		{"playwright implicit xpath", "//div >> css=a.title", models.SelectorDialect_Playwright, true},
+		{"playwright quoted text", `"Read more"`, models.SelectorDialect_Playwright, true},
+		{"playwright visible", "button >> visible=true", models.SelectorDialect_Playwright, true},
+		{"playwright empty part", "article >> ", models.SelectorDialect_Playwright, false},
+		{"playwright unknown engine", "foo=bar", models.SelectorDialect_Playwright, false},
+		{"playwright bad nth", "li >> nth=first", models.SelectorDialect_Playwright, false},
+		{"playwright bad css", "article >>> h2", models.SelectorDialect_Playwright, false},
+		{"playwright bad xpath", "xpath=//div[", models.SelectorDialect_Playwright, false},
+		{"playwright unclosed quote", `text="Read more`, models.SelectorDialect_Playwright, false},
+
+		{"unknown dialect", "a", 100, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckSelector(tt.selector, tt.dialect)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSelectorDialectParam(t *testing.T) {
+	type item struct {
+		Selector        string `validate:"selector=SelectorDialect"`
+		SelectorDialect models.SelectorDialect
+	}
+	type specs struct {
+		Css   string  `validate:"omitempty,selector"`
+		Items []*item `validate:"dive"`
+	}
+	validate := validator.New()
+	require.NoError(t, validate.RegisterValidation("selector", ValidateSelector))
+
+	assert.NoError(t, validate.Struct(specs{Css: "a.title", Items: []*item{
+		{Selector: "//a[@rel='next']", SelectorDialect: models.SelectorDialect_XPath},
+		{Selector: "a:has-text('Next')", SelectorDialect: models.SelectorDialect_Playwright},
+	}}))
+	assert.Error(t, validate.Struct(specs{Css: "a:has-text('Next')"}))
+	assert.Error(t, validate.Struct(specs{Items: []*item{{Selector: "//a[@rel='next']"}}}))
+}
@@ -1,17 +1,32 @@
 package validators
 
 import (
-	"github.com/ericchiang/css"
+	"github.com/egor3f/rssalchemy/internal/models"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/gommon/log"
 	"reflect"
 )
 
+// ValidateSelector checks selector syntax.
+// Optional param is a name of sibling field with selector dialect, css is used without it
 func ValidateSelector(fl validator.FieldLevel) bool {
 	if fl.Field().Kind() != reflect.String {
 		return false
 	}
-	_, err := css.Parse(fl.Field().String())
+	dialect := models.SelectorDialect_Css
+	if len(fl.Param()) > 0 {
+		parent := fl.Parent()
+		if parent.Kind() == reflect.Pointer {
+			parent = parent.Elem()
+		}
+		dialectField := parent.FieldByName(fl.Param())
+		if !dialectField.IsValid() || !dialectField.CanInt() {
+			log.Errorf("selector dialect field %s not found", fl.Param())
+			return false
+		}
+		dialect = models.SelectorDialect(dialectField.Int())
+	}
+	err := CheckSelector(fl.Field().String(), dialect)
 	if err != nil {
 		log.Debugf("selector %s invalid: %v", fl.Field().String(), err)
 	}